@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aggregate
+
+import (
+	"math/big"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// BigDecimalAverage returns the average of values of the given attribute as a types.Decimal.
+// The values must be java.math.BigDecimal on the member side.
+func BigDecimalAverage(attr string) *aggBigDecimalAverage {
+	return &aggBigDecimalAverage{attrPath: attr}
+}
+
+// BigDecimalAverageAll returns the average of all values as a types.Decimal.
+func BigDecimalAverageAll() *aggBigDecimalAverage {
+	return &aggBigDecimalAverage{attrPath: ""}
+}
+
+// BigDecimalSum returns the sum of values of the given attribute as a types.Decimal.
+// The values must be java.math.BigDecimal on the member side.
+func BigDecimalSum(attr string) *aggBigDecimalSum {
+	return &aggBigDecimalSum{attrPath: attr}
+}
+
+// BigDecimalSumAll returns the sum of all values as a types.Decimal.
+func BigDecimalSumAll() *aggBigDecimalSum {
+	return &aggBigDecimalSum{attrPath: ""}
+}
+
+type aggBigDecimalAverage struct {
+	attrPath string
+}
+
+func (a aggBigDecimalAverage) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggBigDecimalAverage) ClassID() (classID int32) {
+	return 0
+}
+
+func (a aggBigDecimalAverage) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteObject(types.NewDecimal(new(big.Int), 0))
+	output.WriteInt64(0)
+}
+
+func (a *aggBigDecimalAverage) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadObject()
+	input.ReadInt64()
+}
+
+func (a aggBigDecimalAverage) String() string {
+	return makeString("BigDecimalAverage", a.attrPath)
+}
+
+type aggBigDecimalSum struct {
+	attrPath string
+}
+
+func (a aggBigDecimalSum) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggBigDecimalSum) ClassID() (classID int32) {
+	return 1
+}
+
+func (a aggBigDecimalSum) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteObject(types.NewDecimal(new(big.Int), 0))
+}
+
+func (a *aggBigDecimalSum) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadObject()
+}
+
+func (a aggBigDecimalSum) String() string {
+	return makeString("BigDecimalSum", a.attrPath)
+}
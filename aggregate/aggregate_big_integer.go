@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aggregate
+
+import (
+	"math/big"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// BigIntegerAverage returns the average of values of the given attribute as a types.Decimal.
+// The values must be java.math.BigInteger on the member side.
+func BigIntegerAverage(attr string) *aggBigIntegerAverage {
+	return &aggBigIntegerAverage{attrPath: attr}
+}
+
+// BigIntegerAverageAll returns the average of all values as a types.Decimal.
+func BigIntegerAverageAll() *aggBigIntegerAverage {
+	return &aggBigIntegerAverage{attrPath: ""}
+}
+
+// BigIntegerSum returns the sum of values of the given attribute as a *big.Int.
+// The values must be java.math.BigInteger on the member side.
+func BigIntegerSum(attr string) *aggBigIntegerSum {
+	return &aggBigIntegerSum{attrPath: attr}
+}
+
+// BigIntegerSumAll returns the sum of all values as a *big.Int.
+func BigIntegerSumAll() *aggBigIntegerSum {
+	return &aggBigIntegerSum{attrPath: ""}
+}
+
+type aggBigIntegerAverage struct {
+	attrPath string
+}
+
+func (a aggBigIntegerAverage) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggBigIntegerAverage) ClassID() (classID int32) {
+	return 2
+}
+
+func (a aggBigIntegerAverage) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteObject(new(big.Int))
+	output.WriteInt64(0)
+}
+
+func (a *aggBigIntegerAverage) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadObject()
+	input.ReadInt64()
+}
+
+func (a aggBigIntegerAverage) String() string {
+	return makeString("BigIntegerAverage", a.attrPath)
+}
+
+type aggBigIntegerSum struct {
+	attrPath string
+}
+
+func (a aggBigIntegerSum) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggBigIntegerSum) ClassID() (classID int32) {
+	return 3
+}
+
+func (a aggBigIntegerSum) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteObject(new(big.Int))
+}
+
+func (a *aggBigIntegerSum) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadObject()
+}
+
+func (a aggBigIntegerSum) String() string {
+	return makeString("BigIntegerSum", a.attrPath)
+}
@@ -18,6 +18,7 @@ package aggregate_test
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -25,6 +26,7 @@ import (
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 func TestCount(t *testing.T) {
@@ -391,3 +393,93 @@ func TestMaxAll(t *testing.T) {
 		assert.Equal(t, int64(30), result)
 	})
 }
+
+func TestFixedPointSum(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", &it.SamplePortable{A: "foo", B: 10}))
+		it.MustValue(m.Put(ctx, "k2", &it.SamplePortable{A: "bar", B: 30}))
+		it.MustValue(m.Put(ctx, "k3", &it.SamplePortable{A: "zoo", B: 30}))
+		result, err := m.Aggregate(ctx, aggregate.FixedPointSum("B"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, int64(70), result)
+	})
+}
+
+func TestFloatingPointSumAll(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", 10))
+		it.MustValue(m.Put(ctx, "k2", 2.5))
+		it.MustValue(m.Put(ctx, "k3", int32(30)))
+		result, err := m.Aggregate(ctx, aggregate.FloatingPointSumAll())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, float64(42.5), result)
+	})
+}
+
+func TestBigIntegerSumAll(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		huge, _ := new(big.Int).SetString("100000000000000000000", 10)
+		it.MustValue(m.Put(ctx, "k1", huge))
+		it.MustValue(m.Put(ctx, "k2", big.NewInt(-30)))
+		result, err := m.Aggregate(ctx, aggregate.BigIntegerSumAll())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "99999999999999999970", result.(*big.Int).String())
+	})
+}
+
+func TestBigDecimalAverageAll(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", types.NewDecimal(big.NewInt(150), 2)))
+		it.MustValue(m.Put(ctx, "k2", types.NewDecimal(big.NewInt(250), 2)))
+		result, err := m.Aggregate(ctx, aggregate.BigDecimalAverageAll())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "2.00", result.(types.Decimal).String())
+	})
+}
+
+func TestMaxBy(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", &it.SamplePortable{A: "foo", B: 10}))
+		it.MustValue(m.Put(ctx, "k2", &it.SamplePortable{A: "bar", B: 30}))
+		result, err := m.Aggregate(ctx, aggregate.MaxBy("B"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, types.NewEntry("k2", &it.SamplePortable{A: "bar", B: 30}), result)
+	})
+}
+
+func TestMinBy(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", &it.SamplePortable{A: "foo", B: 10}))
+		it.MustValue(m.Put(ctx, "k2", &it.SamplePortable{A: "bar", B: 30}))
+		result, err := m.Aggregate(ctx, aggregate.MinBy("B"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, types.NewEntry("k1", &it.SamplePortable{A: "foo", B: 10}), result)
+	})
+}
@@ -96,3 +96,75 @@ func (a *aggMax) ReadData(input serialization.DataInput) {
 func (a aggMax) String() string {
 	return makeString("Max", a.attrPath)
 }
+
+// MinBy returns the entry which has the minimum value of the given attribute.
+// The result is a types.Entry.
+func MinBy(attr string) *aggMinBy {
+	return &aggMinBy{attrPath: attr}
+}
+
+// MaxBy returns the entry which has the maximum value of the given attribute.
+// The result is a types.Entry.
+func MaxBy(attr string) *aggMaxBy {
+	return &aggMaxBy{attrPath: attr}
+}
+
+type aggMinBy struct {
+	attrPath string
+}
+
+func (a aggMinBy) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggMinBy) ClassID() (classID int32) {
+	return 18
+}
+
+func (a aggMinBy) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteObject(nil)
+	output.WriteObject(nil)
+}
+
+func (a *aggMinBy) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadObject()
+	input.ReadObject()
+}
+
+func (a aggMinBy) String() string {
+	return makeString("MinBy", a.attrPath)
+}
+
+type aggMaxBy struct {
+	attrPath string
+}
+
+func (a aggMaxBy) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggMaxBy) ClassID() (classID int32) {
+	return 17
+}
+
+func (a aggMaxBy) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteObject(nil)
+	output.WriteObject(nil)
+}
+
+func (a *aggMaxBy) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadObject()
+	input.ReadObject()
+}
+
+func (a aggMaxBy) String() string {
+	return makeString("MaxBy", a.attrPath)
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aggregate
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// FixedPointSum returns the sum of values of the given attribute as an int64.
+// Values of any integral type are accepted, floating point values are truncated.
+func FixedPointSum(attr string) *aggFixedPointSum {
+	return &aggFixedPointSum{attrPath: attr}
+}
+
+// FixedPointSumAll returns the sum of all values as an int64.
+func FixedPointSumAll() *aggFixedPointSum {
+	return &aggFixedPointSum{attrPath: ""}
+}
+
+// FloatingPointSum returns the sum of values of the given attribute as a float64.
+// Values of any numeric type are accepted.
+func FloatingPointSum(attr string) *aggFloatingPointSum {
+	return &aggFloatingPointSum{attrPath: attr}
+}
+
+// FloatingPointSumAll returns the sum of all values as a float64.
+func FloatingPointSumAll() *aggFloatingPointSum {
+	return &aggFloatingPointSum{attrPath: ""}
+}
+
+type aggFixedPointSum struct {
+	attrPath string
+}
+
+func (a aggFixedPointSum) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggFixedPointSum) ClassID() (classID int32) {
+	return 8
+}
+
+func (a aggFixedPointSum) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteInt64(0)
+}
+
+func (a *aggFixedPointSum) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadInt64()
+}
+
+func (a aggFixedPointSum) String() string {
+	return makeString("FixedPointSum", a.attrPath)
+}
+
+type aggFloatingPointSum struct {
+	attrPath string
+}
+
+func (a aggFloatingPointSum) FactoryID() int32 {
+	return internal.AggregateFactoryID
+}
+
+func (a aggFloatingPointSum) ClassID() (classID int32) {
+	return 9
+}
+
+func (a aggFloatingPointSum) WriteData(output serialization.DataOutput) {
+	writeAttrPath(output, a.attrPath)
+	// member side, not used in client
+	output.WriteFloat64(0)
+}
+
+func (a *aggFloatingPointSum) ReadData(input serialization.DataInput) {
+	a.attrPath = input.ReadString()
+	// member side, not used in client
+	input.ReadFloat64()
+}
+
+func (a aggFloatingPointSum) String() string {
+	return makeString("FloatingPointSum", a.attrPath)
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestAtomicLong_GetSet(t *testing.T) {
+	atomicLongTester(t, "atomic-long", func(t *testing.T, a *hz.AtomicLong) {
+		ctx := context.Background()
+		assert.Equal(t, int64(0), it.MustValue(a.Get(ctx)))
+		if err := a.Set(ctx, 42); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, int64(42), it.MustValue(a.Get(ctx)))
+		assert.Equal(t, int64(42), it.MustValue(a.GetAndSet(ctx, 10)))
+		assert.Equal(t, int64(10), it.MustValue(a.Get(ctx)))
+	})
+}
+
+func TestAtomicLong_AddAndGet(t *testing.T) {
+	atomicLongTester(t, "atomic-long", func(t *testing.T, a *hz.AtomicLong) {
+		ctx := context.Background()
+		assert.Equal(t, int64(5), it.MustValue(a.AddAndGet(ctx, 5)))
+		assert.Equal(t, int64(5), it.MustValue(a.GetAndAdd(ctx, 3)))
+		assert.Equal(t, int64(9), it.MustValue(a.IncrementAndGet(ctx)))
+		assert.Equal(t, int64(9), it.MustValue(a.GetAndIncrement(ctx)))
+		assert.Equal(t, int64(9), it.MustValue(a.DecrementAndGet(ctx)))
+		assert.Equal(t, int64(9), it.MustValue(a.GetAndDecrement(ctx)))
+		assert.Equal(t, int64(8), it.MustValue(a.Get(ctx)))
+	})
+}
+
+func TestAtomicLong_CompareAndSet(t *testing.T) {
+	atomicLongTester(t, "atomic-long", func(t *testing.T, a *hz.AtomicLong) {
+		ctx := context.Background()
+		assert.Equal(t, true, it.MustValue(a.CompareAndSet(ctx, 0, 5)))
+		assert.Equal(t, false, it.MustValue(a.CompareAndSet(ctx, 0, 10)))
+		assert.Equal(t, int64(5), it.MustValue(a.Get(ctx)))
+	})
+}
+
+func TestAtomicLong_CustomGroup(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		name := it.NewUniqueObjectName("atomic-long")
+		a1, err := client.CPSubsystem().GetAtomicLong(ctx, name+"@group1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer a1.Destroy(ctx)
+		a2, err := client.CPSubsystem().GetAtomicLong(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer a2.Destroy(ctx)
+		it.MustValue(a1.AddAndGet(ctx, 1))
+		// the data structures with the same name in different groups are distinct
+		assert.Equal(t, int64(1), it.MustValue(a1.Get(ctx)))
+		assert.Equal(t, int64(0), it.MustValue(a2.Get(ctx)))
+	})
+}
+
+func atomicLongTester(t *testing.T, prefix string, f func(t *testing.T, a *hz.AtomicLong)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		a, err := client.CPSubsystem().GetAtomicLong(ctx, it.NewUniqueObjectName(prefix))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := a.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy atomic long: %s", err.Error())
+			}
+		}()
+		f(t, a)
+	})
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestAtomicReference_GetSet(t *testing.T) {
+	atomicReferenceTester(t, func(t *testing.T, a *hz.AtomicReference) {
+		ctx := context.Background()
+		assert.Equal(t, nil, it.MustValue(a.Get(ctx)))
+		assert.Equal(t, true, it.MustValue(a.IsNil(ctx)))
+		if err := a.Set(ctx, "value"); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "value", it.MustValue(a.Get(ctx)))
+		assert.Equal(t, "value", it.MustValue(a.GetAndSet(ctx, "other-value")))
+		assert.Equal(t, true, it.MustValue(a.Contains(ctx, "other-value")))
+		if err := a.Clear(ctx); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, true, it.MustValue(a.IsNil(ctx)))
+	})
+}
+
+func TestAtomicReference_CompareAndSet(t *testing.T) {
+	atomicReferenceTester(t, func(t *testing.T, a *hz.AtomicReference) {
+		ctx := context.Background()
+		assert.Equal(t, true, it.MustValue(a.CompareAndSet(ctx, nil, "value")))
+		assert.Equal(t, false, it.MustValue(a.CompareAndSet(ctx, "other-value", "value")))
+		assert.Equal(t, true, it.MustValue(a.CompareAndSet(ctx, "value", int64(42))))
+		assert.Equal(t, int64(42), it.MustValue(a.Get(ctx)))
+	})
+}
+
+func atomicReferenceTester(t *testing.T, f func(t *testing.T, a *hz.AtomicReference)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		a, err := client.CPSubsystem().GetAtomicReference(ctx, it.NewUniqueObjectName("atomic-reference"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := a.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy atomic reference: %s", err.Error())
+			}
+		}()
+		f(t, a)
+	})
+}
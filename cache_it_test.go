@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestCache_PutGet(t *testing.T) {
+	cacheTester(t, func(t *testing.T, c *hz.Cache) {
+		ctx := context.Background()
+		it.Must(c.Put(ctx, "k1", "v1"))
+		assert.Equal(t, "v1", it.MustValue(c.Get(ctx, "k1")))
+		assert.Equal(t, nil, it.MustValue(c.Get(ctx, "k2")))
+		assert.Equal(t, "v1", it.MustValue(c.GetAndPut(ctx, "k1", "v2")))
+		assert.Equal(t, true, it.MustValue(c.ContainsKey(ctx, "k1")))
+		assert.Equal(t, false, it.MustValue(c.ContainsKey(ctx, "k2")))
+		assert.Equal(t, 1, it.MustValue(c.Size(ctx)))
+	})
+}
+
+func TestCache_GetAll(t *testing.T) {
+	cacheTester(t, func(t *testing.T, c *hz.Cache) {
+		ctx := context.Background()
+		it.Must(c.Put(ctx, "k1", "v1"))
+		it.Must(c.Put(ctx, "k2", "v2"))
+		target := []types.Entry{
+			types.NewEntry("k1", "v1"),
+			types.NewEntry("k2", "v2"),
+		}
+		assert.ElementsMatch(t, target, it.MustValue(c.GetAll(ctx, "k1", "k2", "k3")))
+	})
+}
+
+func TestCache_PutIfAbsentReplaceRemove(t *testing.T) {
+	cacheTester(t, func(t *testing.T, c *hz.Cache) {
+		ctx := context.Background()
+		assert.Equal(t, false, it.MustValue(c.Replace(ctx, "k1", "v0")))
+		assert.Equal(t, true, it.MustValue(c.PutIfAbsent(ctx, "k1", "v1")))
+		assert.Equal(t, false, it.MustValue(c.PutIfAbsent(ctx, "k1", "v2")))
+		assert.Equal(t, true, it.MustValue(c.Replace(ctx, "k1", "v3")))
+		assert.Equal(t, "v3", it.MustValue(c.Get(ctx, "k1")))
+		assert.Equal(t, true, it.MustValue(c.Remove(ctx, "k1")))
+		assert.Equal(t, false, it.MustValue(c.Remove(ctx, "k1")))
+	})
+}
+
+func TestCache_Clear(t *testing.T) {
+	cacheTester(t, func(t *testing.T, c *hz.Cache) {
+		ctx := context.Background()
+		it.Must(c.Put(ctx, "k1", "v1"))
+		it.Must(c.Put(ctx, "k2", "v2"))
+		it.Must(c.Clear(ctx))
+		assert.Equal(t, 0, it.MustValue(c.Size(ctx)))
+	})
+}
+
+func TestCache_PutWithExpiryPolicy(t *testing.T) {
+	cacheTester(t, func(t *testing.T, c *hz.Cache) {
+		ctx := context.Background()
+		policy := hz.CacheExpiryPolicy{
+			Creation: time.Second,
+			Access:   hz.CacheExpiryUnchanged,
+			Update:   hz.CacheExpiryUnchanged,
+		}
+		it.Must(c.PutWithExpiryPolicy(ctx, "k1", "v1", policy))
+		assert.Equal(t, "v1", it.MustValue(c.Get(ctx, "k1")))
+		it.Eventually(t, func() bool {
+			return it.MustValue(c.Get(ctx, "k1")) == nil
+		})
+	})
+}
+
+func TestCache_EntryListener(t *testing.T) {
+	cacheTester(t, func(t *testing.T, c *hz.Cache) {
+		ctx := context.Background()
+		var created, updated, removed int32
+		subscriptionID, err := c.AddEntryListener(ctx, func(event *hz.CacheEntryNotified) {
+			switch event.EventType {
+			case hz.CacheEntryCreated:
+				atomic.AddInt32(&created, 1)
+			case hz.CacheEntryUpdated:
+				atomic.AddInt32(&updated, 1)
+			case hz.CacheEntryRemoved:
+				atomic.AddInt32(&removed, 1)
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.Must(c.Put(ctx, "k1", "v1"))
+		it.Must(c.Put(ctx, "k1", "v2"))
+		it.MustValue(c.Remove(ctx, "k1"))
+		it.Eventually(t, func() bool {
+			return atomic.LoadInt32(&created) == 1 && atomic.LoadInt32(&updated) == 1 && atomic.LoadInt32(&removed) == 1
+		})
+		it.Must(c.RemoveEntryListener(ctx, subscriptionID))
+	})
+}
+
+func TestCache_NotConfigured(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		_, err := client.GetCache(context.Background(), it.NewUniqueObjectName("unconfigured-cache"))
+		if !errors.Is(err, hzerrors.ErrCacheNotExists) {
+			t.Fatalf("expected cache not exists error, got: %v", err)
+		}
+	})
+}
+
+func cacheTester(t *testing.T, f func(t *testing.T, c *hz.Cache)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		// the caches with the test-cache prefix are configured on the test cluster
+		c, err := client.GetCache(ctx, it.NewUniqueObjectName("cache"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := c.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy cache: %s", err.Error())
+			}
+		}()
+		f(t, c)
+	})
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func TestCacheExpiryPolicyIsIdentifiedDataSerializable(t *testing.T) {
+	policy := hz.CacheExpiryPolicy{
+		Creation: time.Minute,
+		Access:   hz.CacheExpiryUnchanged,
+		Update:   time.Second,
+	}
+	data, err := hz.CacheExpiryPolicyData(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int32(iserialization.TypeDataSerializable), data.Type())
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestCardinalityEstimator_Estimate(t *testing.T) {
+	cardinalityEstimatorTester(t, func(t *testing.T, c *hz.CardinalityEstimator) {
+		ctx := context.Background()
+		assert.Equal(t, int64(0), it.MustValue(c.Estimate(ctx)))
+		for i := 0; i < 100; i++ {
+			// add each item twice, the duplicates should not change the estimate
+			it.Must(c.Add(ctx, fmt.Sprintf("item-%d", i)))
+			it.Must(c.Add(ctx, fmt.Sprintf("item-%d", i)))
+		}
+		estimate := it.MustValue(c.Estimate(ctx)).(int64)
+		// the estimate is exact for low cardinalities
+		assert.InDelta(t, 100, estimate, 2)
+	})
+}
+
+func TestCardinalityEstimator_AddNil(t *testing.T) {
+	cardinalityEstimatorTester(t, func(t *testing.T, c *hz.CardinalityEstimator) {
+		if err := c.Add(context.Background(), nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func cardinalityEstimatorTester(t *testing.T, f func(t *testing.T, c *hz.CardinalityEstimator)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		c, err := client.GetCardinalityEstimator(ctx, it.NewUniqueObjectName("cardinality-estimator"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := c.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy cardinality estimator: %s", err.Error())
+			}
+		}()
+		f(t, c)
+	})
+}
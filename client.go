@@ -30,12 +30,16 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/cloud"
 	icluster "github.com/hazelcast/hazelcast-go-client/internal/cluster"
 	"github.com/hazelcast/hazelcast-go-client/internal/event"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
 	"github.com/hazelcast/hazelcast-go-client/internal/lifecycle"
 	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	isql "github.com/hazelcast/hazelcast-go-client/internal/sql"
 	"github.com/hazelcast/hazelcast-go-client/internal/stats"
+	"github.com/hazelcast/hazelcast-go-client/sql"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -84,7 +88,11 @@ type Client struct {
 	serializationService    *serialization.Service
 	eventDispatcher         *event.DispatchService
 	proxyManager            *proxyManager
+	cpSubsystem             *CPSubsystem
+	partitions              *PartitionService
+	sqlService              *isql.Service
 	statsService            *stats.Service
+	nearCacheManager        *inearcache.Manager
 	heartbeatService        *icluster.HeartbeatService
 	clusterConfig           *cluster.Config
 	membershipListenerMap   map[types.UUID]int64
@@ -188,6 +196,38 @@ func (c *Client) GetTopic(ctx context.Context, name string) (*Topic, error) {
 	return c.proxyManager.getTopic(ctx, name)
 }
 
+// GetExecutorService returns an ExecutorService instance.
+func (c *Client) GetExecutorService(ctx context.Context, name string) (*ExecutorService, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getExecutorService(ctx, name)
+}
+
+// GetDurableExecutorService returns a DurableExecutorService instance.
+func (c *Client) GetDurableExecutorService(ctx context.Context, name string) (*DurableExecutorService, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getDurableExecutorService(ctx, name)
+}
+
+// GetScheduledExecutorService returns a ScheduledExecutorService instance.
+func (c *Client) GetScheduledExecutorService(ctx context.Context, name string) (*ScheduledExecutorService, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getScheduledExecutorService(ctx, name)
+}
+
+// GetReliableTopic returns a ReliableTopic instance.
+func (c *Client) GetReliableTopic(ctx context.Context, name string) (*ReliableTopic, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getReliableTopic(ctx, name)
+}
+
 // GetSet returns a set instance.
 func (c *Client) GetSet(ctx context.Context, name string) (*Set, error) {
 	if atomic.LoadInt32(&c.state) != ready {
@@ -212,6 +252,63 @@ func (c *Client) GetFlakeIDGenerator(ctx context.Context, name string) (*FlakeID
 	return c.proxyManager.getFlakeIDGenerator(ctx, name)
 }
 
+// GetRingbuffer returns a Ringbuffer instance.
+func (c *Client) GetRingbuffer(ctx context.Context, name string) (*Ringbuffer, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getRingbuffer(ctx, name)
+}
+
+// GetCardinalityEstimator returns a CardinalityEstimator instance.
+func (c *Client) GetCardinalityEstimator(ctx context.Context, name string) (*CardinalityEstimator, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getCardinalityEstimator(ctx, name)
+}
+
+// GetCache returns a Cache instance.
+// The cache must be configured on the cluster or created by a Java client or member beforehand.
+func (c *Client) GetCache(ctx context.Context, name string) (*Cache, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	return c.proxyManager.getCache(ctx, name)
+}
+
+// CPSubsystem returns the CP Subsystem API, which provides access to the CP data structures.
+func (c *Client) CPSubsystem() *CPSubsystem {
+	return c.cpSubsystem
+}
+
+// PartitionService returns the service to listen to the partition events of the cluster.
+func (c *Client) PartitionService() *PartitionService {
+	return c.partitions
+}
+
+// SQL returns the service to run SQL queries on the cluster.
+func (c *Client) SQL() sql.Service {
+	return c.sqlService
+}
+
+// NewTransactionContext creates a TransactionContext with the given options.
+// The operations of the transaction are sent over one of the connections to the cluster,
+// so the client must be connected when the transaction context is created.
+func (c *Client) NewTransactionContext(options TransactionOptions) (*TransactionContext, error) {
+	if atomic.LoadInt32(&c.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	conn := c.connectionManager.RandomConnection()
+	if conn == nil {
+		return nil, ihzerrors.NewIOError("no connection found for the transaction", nil)
+	}
+	return newTransactionContext(c.proxyManager, conn, options), nil
+}
+
 // GetDistributedObjectsInfo returns the information of all objects created cluster-wide.
 func (c *Client) GetDistributedObjectsInfo(ctx context.Context) ([]types.DistributedObjectInfo, error) {
 	if atomic.LoadInt32(&c.state) != ready {
@@ -257,9 +354,11 @@ func (c *Client) Shutdown(ctx context.Context) error {
 		ctx = context.Background()
 	}
 	c.eventDispatcher.Publish(lifecycle.NewLifecycleStateChanged(lifecycle.StateShuttingDown))
+	c.cpSubsystem.sessions.shutdownSessions(ctx)
 	c.invocationService.Stop()
 	c.heartbeatService.Stop()
 	c.connectionManager.Stop()
+	c.nearCacheManager.Stop()
 	if c.statsService != nil {
 		c.statsService.Stop()
 	}
@@ -472,6 +571,15 @@ func (c *Client) createComponents(config *Config) {
 		c.eventDispatcher,
 		c.logger,
 		!config.Cluster.Unisocket)
+	nearCacheManager := inearcache.NewManager(inearcache.ManagerCreationBundle{
+		SerializationService: c.serializationService,
+		PartitionService:     partitionService,
+		ClusterService:       clusterService,
+		InvocationService:    invocationService,
+		InvocationFactory:    invocationFactory,
+		Logger:               c.logger,
+		ClientUUID:           connectionManager.ClientUUID(),
+	})
 	proxyManagerServiceBundle := creationBundle{
 		InvocationService:    invocationService,
 		SerializationService: c.serializationService,
@@ -480,6 +588,7 @@ func (c *Client) createComponents(config *Config) {
 		Config:               config,
 		InvocationFactory:    invocationFactory,
 		ListenerBinder:       listenerBinder,
+		NearCacheManager:     nearCacheManager,
 		Logger:               c.logger,
 	}
 	c.heartbeatService = icluster.NewHeartbeatService(connectionManager, invocationFactory, invocationService, c.logger)
@@ -488,6 +597,7 @@ func (c *Client) createComponents(config *Config) {
 			invocationService,
 			invocationFactory,
 			c.eventDispatcher,
+			nearCacheManager,
 			c.logger,
 			time.Duration(config.Stats.Period),
 			c.name)
@@ -496,7 +606,17 @@ func (c *Client) createComponents(config *Config) {
 	c.clusterService = clusterService
 	c.partitionService = partitionService
 	c.invocationService = invocationService
+	c.nearCacheManager = nearCacheManager
 	c.proxyManager = newProxyManager(proxyManagerServiceBundle)
+	c.cpSubsystem = newCPSubsystem(c)
+	c.partitions = newPartitionService(c)
+	c.sqlService = isql.NewService(isql.ServiceCreationBundle{
+		SerializationService: c.serializationService,
+		ClusterService:       clusterService,
+		InvocationService:    invocationService,
+		InvocationFactory:    invocationFactory,
+		ClientUUID:           connectionManager.ClientUUID(),
+	})
 	c.invocationHandler = invocationHandler
 	c.viewListenerService = viewListener
 	c.connectionManager.SetInvocationService(invocationService)
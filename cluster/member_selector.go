@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster
+
+// MemberSelector selects the members which an operation is executed on.
+type MemberSelector interface {
+	// Select returns true if the operation should be executed on the given member.
+	Select(member MemberInfo) bool
+}
+
+// MemberSelectorFunc is a function which can be used as a MemberSelector.
+type MemberSelectorFunc func(member MemberInfo) bool
+
+// Select calls f(member).
+func (f MemberSelectorFunc) Select(member MemberInfo) bool {
+	return f(member)
+}
+
+// DataMemberSelector selects the data members, which are the members that are not lite members.
+func DataMemberSelector() MemberSelector {
+	return MemberSelectorFunc(func(member MemberInfo) bool {
+		return !member.LiteMember
+	})
+}
+
+// LiteMemberSelector selects the lite members.
+func LiteMemberSelector() MemberSelector {
+	return MemberSelectorFunc(func(member MemberInfo) bool {
+		return member.LiteMember
+	})
+}
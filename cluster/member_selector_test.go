@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+)
+
+func TestMemberSelector(t *testing.T) {
+	dataMember := cluster.MemberInfo{Address: "100.15.20.33:5701"}
+	liteMember := cluster.MemberInfo{Address: "100.15.20.34:5701", LiteMember: true}
+	assert.True(t, cluster.DataMemberSelector().Select(dataMember))
+	assert.False(t, cluster.DataMemberSelector().Select(liteMember))
+	assert.False(t, cluster.LiteMemberSelector().Select(dataMember))
+	assert.True(t, cluster.LiteMemberSelector().Select(liteMember))
+	byAddr := cluster.MemberSelectorFunc(func(member cluster.MemberInfo) bool {
+		return member.Address == "100.15.20.34:5701"
+	})
+	assert.False(t, byAddr.Select(dataMember))
+	assert.True(t, byAddr.Select(liteMember))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	"github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/logger"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -34,6 +35,7 @@ type Config struct {
 	lifecycleListeners  map[types.UUID]LifecycleStateChangeHandler
 	membershipListeners map[types.UUID]cluster.MembershipStateChangeHandler
 	FlakeIDGenerators   map[string]FlakeIDGeneratorConfig `json:",omitempty"`
+	NearCaches          map[string]nearcache.Config       `json:",omitempty"`
 	Labels              []string                          `json:",omitempty"`
 	ClientName          string                            `json:",omitempty"`
 	Logger              logger.Config                     `json:",omitempty"`
@@ -85,10 +87,15 @@ func (c *Config) Clone() Config {
 	for k, v := range c.FlakeIDGenerators {
 		newFlakeIDConfigs[k] = v
 	}
+	newNearCacheConfigs := make(map[string]nearcache.Config, len(c.NearCaches))
+	for k, v := range c.NearCaches {
+		newNearCacheConfigs[k] = v.Clone()
+	}
 	return Config{
 		ClientName:        c.ClientName,
 		Labels:            newLabels,
 		FlakeIDGenerators: newFlakeIDConfigs,
+		NearCaches:        newNearCacheConfigs,
 		Cluster:           c.Cluster.Clone(),
 		Failover:          c.Failover.Clone(),
 		Serialization:     c.Serialization.Clone(),
@@ -124,6 +131,13 @@ func (c *Config) Validate() error {
 			return err
 		}
 	}
+	c.ensureNearCaches()
+	for k, v := range c.NearCaches {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+		c.NearCaches[k] = v
+	}
 	return nil
 }
 
@@ -159,6 +173,25 @@ func (c *Config) AddFlakeIDGenerator(name string, prefetchCount int32, prefetchE
 	return nil
 }
 
+func (c *Config) ensureNearCaches() {
+	if c.NearCaches == nil {
+		c.NearCaches = map[string]nearcache.Config{}
+	}
+}
+
+// AddNearCache validates the given Near Cache configuration and adds it for the Map and ReplicatedMap with the given name.
+func (c *Config) AddNearCache(name string, config nearcache.Config) error {
+	if _, ok := c.NearCaches[name]; ok {
+		return hzerrors.NewIllegalArgumentError(fmt.Sprintf("config already exists for %s", name), nil)
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	c.ensureNearCaches()
+	c.NearCaches[name] = config
+	return nil
+}
+
 // StatsConfig contains configuration for Management Center.
 type StatsConfig struct {
 	// Enabled enables collecting statistics.
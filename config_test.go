@@ -30,6 +30,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal"
 	"github.com/hazelcast/hazelcast-go-client/logger"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -181,6 +182,16 @@ func TestUnmarshalJSONConfig(t *testing.T) {
 			"PrefetchCount": 42,
 			"PrefetchExpiry": "42s"
 		}
+	},
+	"NearCaches": {
+		"baz": {
+			"TimeToLive": "1m",
+			"InMemoryFormat": "OBJECT",
+			"Eviction": {
+				"Policy": "LFU",
+				"Size": 100
+			}
+		}
 	}
 }
 `
@@ -201,6 +212,10 @@ func TestUnmarshalJSONConfig(t *testing.T) {
 	assert.Equal(t, types.Duration(2*time.Minute), config.Stats.Period)
 	assert.Equal(t, int32(42), config.FlakeIDGenerators["bar"].PrefetchCount)
 	assert.Equal(t, types.Duration(42*time.Second), config.FlakeIDGenerators["bar"].PrefetchExpiry)
+	assert.Equal(t, types.Duration(time.Minute), config.NearCaches["baz"].TimeToLive)
+	assert.Equal(t, nearcache.InMemoryFormatObject, config.NearCaches["baz"].InMemoryFormat)
+	assert.Equal(t, nearcache.EvictionPolicyLFU, config.NearCaches["baz"].Eviction.Policy)
+	assert.Equal(t, int32(100), config.NearCaches["baz"].Eviction.Size)
 }
 
 func TestMarshalDefaultConfig(t *testing.T) {
@@ -351,6 +366,24 @@ func TestConfig_AddExistingFlakeIDGenerator(t *testing.T) {
 	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
 }
 
+func TestConfig_AddNearCache(t *testing.T) {
+	config := hazelcast.Config{}
+	assert.NoError(t, config.AddNearCache("foo", nearcache.Config{}))
+	ncc, ok := config.NearCaches["foo"]
+	assert.True(t, ok)
+	assert.Equal(t, int32(nearcache.DefaultEvictionSize), ncc.Eviction.Size)
+	assert.Equal(t, nearcache.EvictionPolicyLRU, ncc.Eviction.Policy)
+	assert.Equal(t, nearcache.InMemoryFormatBinary, ncc.InMemoryFormat)
+	err := config.AddNearCache("foo", nearcache.Config{})
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	err = config.AddNearCache("bar", nearcache.Config{MaxIdle: -1})
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	err = config.AddNearCache("bar", nearcache.Config{Eviction: nearcache.EvictionConfig{Size: -1}})
+	assert.True(t, errors.Is(err, hzerrors.ErrIllegalArgument))
+	_, ok = config.NearCaches["bar"]
+	assert.False(t, ok)
+}
+
 func checkDefault(t *testing.T, c *hazelcast.Config) {
 	assert.Equal(t, "", c.ClientName)
 	assert.Equal(t, []string(nil), c.Labels)
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestCountDownLatch_TrySetCount(t *testing.T) {
+	countDownLatchTester(t, "latch", func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		assert.Equal(t, true, it.MustValue(l.TrySetCount(ctx, 2)))
+		assert.Equal(t, false, it.MustValue(l.TrySetCount(ctx, 5)))
+		assert.Equal(t, 2, it.MustValue(l.GetCount(ctx)))
+		it.Must(l.CountDown(ctx))
+		assert.Equal(t, 1, it.MustValue(l.GetCount(ctx)))
+		it.Must(l.CountDown(ctx))
+		assert.Equal(t, 0, it.MustValue(l.GetCount(ctx)))
+		// counting down a latch with zero count does nothing
+		it.Must(l.CountDown(ctx))
+		assert.Equal(t, 0, it.MustValue(l.GetCount(ctx)))
+		// the latch can be reused once the count reaches zero
+		assert.Equal(t, true, it.MustValue(l.TrySetCount(ctx, 3)))
+	})
+}
+
+func TestCountDownLatch_Await(t *testing.T) {
+	countDownLatchTester(t, "latch", func(t *testing.T, l *hz.CountDownLatch) {
+		ctx := context.Background()
+		it.MustValue(l.TrySetCount(ctx, 1))
+		assert.Equal(t, false, it.MustValue(l.Await(ctx, 100*time.Millisecond)))
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			it.Must(l.CountDown(ctx))
+		}()
+		assert.Equal(t, true, it.MustValue(l.Await(ctx, 10*time.Second)))
+	})
+}
+
+func TestCountDownLatch_AwaitContextCancelled(t *testing.T) {
+	countDownLatchTester(t, "latch", func(t *testing.T, l *hz.CountDownLatch) {
+		it.MustValue(l.TrySetCount(context.Background(), 1))
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		if _, err := l.Await(ctx, time.Minute); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected context deadline exceeded error, got: %v", err)
+		}
+	})
+}
+
+func countDownLatchTester(t *testing.T, prefix string, f func(t *testing.T, l *hz.CountDownLatch)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		l, err := client.CPSubsystem().GetCountDownLatch(ctx, it.NewUniqueObjectName(prefix))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := l.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy count down latch: %s", err.Error())
+			}
+		}()
+		f(t, l)
+	})
+}
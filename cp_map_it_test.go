@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestCPMap_PutGetRemove(t *testing.T) {
+	cpMapTester(t, "cp-map", func(t *testing.T, m *hz.CPMap) {
+		ctx := context.Background()
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "k1")))
+		assert.Equal(t, nil, it.MustValue(m.Put(ctx, "k1", "v1")))
+		assert.Equal(t, "v1", it.MustValue(m.Put(ctx, "k1", "v2")))
+		assert.Equal(t, "v2", it.MustValue(m.Get(ctx, "k1")))
+		assert.Equal(t, "v2", it.MustValue(m.Remove(ctx, "k1")))
+		assert.Equal(t, nil, it.MustValue(m.Remove(ctx, "k1")))
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "k1")))
+	})
+}
+
+func TestCPMap_SetDelete(t *testing.T) {
+	cpMapTester(t, "cp-map", func(t *testing.T, m *hz.CPMap) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", int64(10)))
+		assert.Equal(t, int64(10), it.MustValue(m.Get(ctx, "k1")))
+		it.Must(m.Delete(ctx, "k1"))
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "k1")))
+	})
+}
+
+func TestCPMap_CompareAndSet(t *testing.T) {
+	cpMapTester(t, "cp-map", func(t *testing.T, m *hz.CPMap) {
+		ctx := context.Background()
+		it.Must(m.Set(ctx, "k1", "v1"))
+		assert.Equal(t, false, it.MustValue(m.CompareAndSet(ctx, "k1", "other", "v2")))
+		assert.Equal(t, true, it.MustValue(m.CompareAndSet(ctx, "k1", "v1", "v2")))
+		assert.Equal(t, "v2", it.MustValue(m.Get(ctx, "k1")))
+	})
+}
+
+func cpMapTester(t *testing.T, prefix string, f func(t *testing.T, m *hz.CPMap)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		m, err := client.CPSubsystem().GetMap(ctx, it.NewUniqueObjectName(prefix))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := m.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy CP map: %s", err.Error())
+			}
+		}()
+		f(t, m)
+	})
+}
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+// cpNoSessionID is the session ID used when there is no session for a CP group.
+const cpNoSessionID int64 = -1
+
+// cpSession is a session created on a CP group.
+type cpSession struct {
+	expiresAt    time.Time
+	id           int64
+	acquireCount int32
+}
+
+func (s *cpSession) acquire(count int32) {
+	atomic.AddInt32(&s.acquireCount, count)
+}
+
+func (s *cpSession) release(count int32) {
+	atomic.AddInt32(&s.acquireCount, -count)
+}
+
+func (s *cpSession) inUse() bool {
+	return atomic.LoadInt32(&s.acquireCount) > 0
+}
+
+// valid returns true if the session is in use or not expired yet.
+// Sessions in use are kept alive with heartbeats.
+func (s *cpSession) valid() bool {
+	return s.inUse() || time.Now().Before(s.expiresAt)
+}
+
+// cpSessionCreation is a session creation in progress.
+// The callers which need a session on the same group while it is being created wait for it, instead of creating another one.
+type cpSessionCreation struct {
+	doneCh chan struct{}
+	err    error
+}
+
+// cpSessionManager creates sessions on CP groups for the session-aware CP data structures, such as FencedLock.
+// The sessions in use are kept alive with periodic heartbeats, and all sessions are closed when the client shuts down.
+type cpSessionManager struct {
+	logger          ilogger.Logger
+	invoker         *cpProxy
+	mu              *sync.Mutex
+	sessions        map[codec.RaftGroupId]*cpSession
+	creations       map[codec.RaftGroupId]*cpSessionCreation
+	threadIDs       map[codec.RaftGroupId]int64
+	cancelHeartbeat context.CancelFunc
+	heartbeatDoneCh chan struct{}
+	endpointName    string
+	shutdown        bool
+}
+
+func newCPSessionManager(invoker *cpProxy, endpointName string, lg ilogger.Logger) *cpSessionManager {
+	return &cpSessionManager{
+		logger:       lg,
+		invoker:      invoker,
+		mu:           &sync.Mutex{},
+		sessions:     map[codec.RaftGroupId]*cpSession{},
+		creations:    map[codec.RaftGroupId]*cpSessionCreation{},
+		threadIDs:    map[codec.RaftGroupId]int64{},
+		endpointName: endpointName,
+	}
+}
+
+// sessionID returns the ID of the current session on the given group, or cpNoSessionID if there is none.
+func (m *cpSessionManager) sessionID(groupID codec.RaftGroupId) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.sessions[groupID]; ok {
+		return s.id
+	}
+	return cpNoSessionID
+}
+
+// acquireSession returns the ID of a valid session on the given group, creating one if necessary.
+// The session is kept alive until it is released count times.
+// The lock is not held while the session is created, so a slow group does not block the callers using other groups.
+func (m *cpSessionManager) acquireSession(ctx context.Context, groupID codec.RaftGroupId, count int32) (int64, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		m.mu.Lock()
+		if m.shutdown {
+			m.mu.Unlock()
+			return cpNoSessionID, hzerrors.ErrClientNotActive
+		}
+		if s, ok := m.sessions[groupID]; ok && s.valid() {
+			s.acquire(count)
+			m.mu.Unlock()
+			return s.id, nil
+		}
+		creation, ok := m.creations[groupID]
+		if ok {
+			m.mu.Unlock()
+			select {
+			case <-creation.doneCh:
+			case <-ctx.Done():
+				return cpNoSessionID, ctx.Err()
+			}
+		} else {
+			creation = &cpSessionCreation{doneCh: make(chan struct{})}
+			m.creations[groupID] = creation
+			m.mu.Unlock()
+			m.createSession(ctx, groupID, creation)
+		}
+		if creation.err != nil {
+			return cpNoSessionID, creation.err
+		}
+		// the created session is acquired in the next iteration, unless it was invalidated in the meantime
+	}
+}
+
+// releaseSession decrements the acquire count of the given session, if it is still the current session.
+func (m *cpSessionManager) releaseSession(groupID codec.RaftGroupId, sessionID int64, count int32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.sessions[groupID]; ok && s.id == sessionID {
+		s.release(count)
+	}
+}
+
+// invalidateSession removes the given session, if it is still the current session.
+// It is called when the CP group reports that the session does not exist anymore.
+func (m *cpSessionManager) invalidateSession(groupID codec.RaftGroupId, sessionID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.sessions[groupID]; ok && s.id == sessionID {
+		delete(m.sessions, groupID)
+	}
+}
+
+// uniqueThreadID returns an ID which is unique in the given group, generating one on the first call.
+// It identifies this client in the sessionless CP data structures, such as sessionless semaphores.
+func (m *cpSessionManager) uniqueThreadID(ctx context.Context, groupID codec.RaftGroupId) (int64, error) {
+	m.mu.Lock()
+	id, ok := m.threadIDs[groupID]
+	m.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	request := codec.EncodeCPSessionGenerateThreadIdRequest(groupID)
+	response, err := m.invoker.invokeOnGroup(ctx, groupID, request)
+	if err != nil {
+		return 0, err
+	}
+	id = codec.DecodeCPSessionGenerateThreadIdResponse(response)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.threadIDs[groupID]; ok {
+		// another caller generated an ID for the group concurrently, the first one is kept
+		return existing, nil
+	}
+	m.threadIDs[groupID] = id
+	return id, nil
+}
+
+// createSession creates a session on the given group and starts sending heartbeats, if not started yet.
+// The given creation is completed with the error, if any, and removed from the creations in progress.
+// Must be called without holding m.mu.
+func (m *cpSessionManager) createSession(ctx context.Context, groupID codec.RaftGroupId, creation *cpSessionCreation) {
+	defer close(creation.doneCh)
+	request := codec.EncodeCPSessionCreateSessionRequest(groupID, m.endpointName)
+	response, err := m.invoker.invokeOnGroup(ctx, groupID, request)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.creations, groupID)
+	if err != nil {
+		creation.err = err
+		return
+	}
+	if m.shutdown {
+		// the session expires on the group, since no heartbeats are sent for it
+		creation.err = hzerrors.ErrClientNotActive
+		return
+	}
+	id, ttlMillis, heartbeatMillis := codec.DecodeCPSessionCreateSessionResponse(response)
+	s := &cpSession{
+		id:        id,
+		expiresAt: time.Now().Add(time.Duration(ttlMillis) * time.Millisecond),
+	}
+	m.sessions[groupID] = s
+	if m.cancelHeartbeat == nil {
+		hbCtx, cancel := context.WithCancel(context.Background())
+		m.cancelHeartbeat = cancel
+		m.heartbeatDoneCh = make(chan struct{})
+		go m.heartbeatLoop(hbCtx, time.Duration(heartbeatMillis)*time.Millisecond, m.heartbeatDoneCh)
+	}
+}
+
+func (m *cpSessionManager) heartbeatLoop(ctx context.Context, period time.Duration, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.sendHeartbeats(ctx)
+		}
+	}
+}
+
+// sendHeartbeats sends a heartbeat for each session in use.
+// Sessions which are reported as expired or whose group is destroyed are invalidated.
+func (m *cpSessionManager) sendHeartbeats(ctx context.Context) {
+	m.mu.Lock()
+	sessions := make(map[codec.RaftGroupId]int64, len(m.sessions))
+	for groupID, s := range m.sessions {
+		if s.inUse() {
+			sessions[groupID] = s.id
+		}
+	}
+	m.mu.Unlock()
+	for groupID, sessionID := range sessions {
+		request := codec.EncodeCPSessionHeartbeatSessionRequest(groupID, sessionID)
+		if _, err := m.invoker.invokeOnGroup(ctx, groupID, request); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) || errors.Is(err, hzerrors.ErrCPGroupDestroyedException) {
+				m.invalidateSession(groupID, sessionID)
+				continue
+			}
+			m.logger.Warnf("sending heartbeat for CP session %d: %s", sessionID, err.Error())
+		}
+	}
+}
+
+// shutdownSessions stops sending heartbeats and closes all sessions.
+func (m *cpSessionManager) shutdownSessions(ctx context.Context) {
+	m.mu.Lock()
+	m.shutdown = true
+	cancel, doneCh := m.cancelHeartbeat, m.heartbeatDoneCh
+	sessions := m.sessions
+	m.sessions = map[codec.RaftGroupId]*cpSession{}
+	m.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-doneCh
+	}
+	for groupID, s := range sessions {
+		request := codec.EncodeCPSessionCloseSessionRequest(groupID, s.id)
+		if _, err := m.invoker.invokeOnGroup(ctx, groupID, request); err != nil {
+			m.logger.Debug(func() string { return "closing CP session: " + err.Error() })
+		}
+	}
+}
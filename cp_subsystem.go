@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+const (
+	defaultCPGroupName  = "default"
+	metadataCPGroupName = "metadata"
+)
+
+/*
+CPSubsystem provides access to the data structures of the CP Subsystem.
+
+CP data structures are replicated to the members of a CP group using the Raft consensus algorithm,
+so they stay linearizable even during network partitions, unlike the other data structures which favor availability.
+The CP Subsystem must be enabled on the members.
+
+A CP data structure is placed in a custom CP group by appending "@" and the group name to its name, such as "counter@group1".
+Otherwise, it is placed in the default CP group.
+Note that data structures with the same name but in different CP groups are distinct.
+
+For details see https://docs.hazelcast.com/imdg/latest/cp-subsystem/cp-subsystem.html
+*/
+type CPSubsystem struct {
+	client   *Client
+	targets  *cpGroupTargets
+	sessions *cpSessionManager
+}
+
+func newCPSubsystem(client *Client) *CPSubsystem {
+	targets := newCPGroupTargets()
+	invoker := &cpProxy{proxy: client.proxyManager.invocationProxy, targets: targets}
+	return &CPSubsystem{
+		client:   client,
+		targets:  targets,
+		sessions: newCPSessionManager(invoker, client.name, client.logger),
+	}
+}
+
+// GetAtomicLong returns the AtomicLong instance with the given name.
+// The name may contain the CP group name, such as "counter@group1".
+func (s *CPSubsystem) GetAtomicLong(ctx context.Context, name string) (*AtomicLong, error) {
+	if p, err := s.newCPProxy(ctx, ServiceNameAtomicLong, name); err != nil {
+		return nil, err
+	} else {
+		return newAtomicLong(p), nil
+	}
+}
+
+// GetAtomicReference returns the AtomicReference instance with the given name.
+// The name may contain the CP group name, such as "reference@group1".
+func (s *CPSubsystem) GetAtomicReference(ctx context.Context, name string) (*AtomicReference, error) {
+	if p, err := s.newCPProxy(ctx, ServiceNameAtomicReference, name); err != nil {
+		return nil, err
+	} else {
+		return newAtomicReference(p), nil
+	}
+}
+
+// GetCountDownLatch returns the CountDownLatch instance with the given name.
+// The name may contain the CP group name, such as "latch@group1".
+func (s *CPSubsystem) GetCountDownLatch(ctx context.Context, name string) (*CountDownLatch, error) {
+	if p, err := s.newCPProxy(ctx, ServiceNameCountDownLatch, name); err != nil {
+		return nil, err
+	} else {
+		return newCountDownLatch(p), nil
+	}
+}
+
+// GetFencedLock returns the FencedLock instance with the given name.
+// The name may contain the CP group name, such as "lock@group1".
+func (s *CPSubsystem) GetFencedLock(ctx context.Context, name string) (*FencedLock, error) {
+	if p, err := s.newCPProxy(ctx, ServiceNameFencedLock, name); err != nil {
+		return nil, err
+	} else {
+		return newFencedLock(p, s.sessions), nil
+	}
+}
+
+// GetMap returns the CPMap instance with the given name.
+// The name may contain the CP group name, such as "flags@group1".
+func (s *CPSubsystem) GetMap(ctx context.Context, name string) (*CPMap, error) {
+	if p, err := s.newCPProxy(ctx, ServiceNameCPMap, name); err != nil {
+		return nil, err
+	} else {
+		return newCPMap(p), nil
+	}
+}
+
+// GetSemaphore returns the Semaphore instance with the given name.
+// The name may contain the CP group name, such as "semaphore@group1".
+// The semaphore is sessionless if JDK compatibility is enabled for it on the members, otherwise it is session-aware.
+func (s *CPSubsystem) GetSemaphore(ctx context.Context, name string) (*Semaphore, error) {
+	p, err := s.newCPProxy(ctx, ServiceNameSemaphore, name)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeSemaphoreGetSemaphoreTypeRequest(p.proxyName)
+	response, err := p.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	sessionless := codec.DecodeSemaphoreGetSemaphoreTypeResponse(response)
+	return newSemaphore(p, s.sessions, sessionless), nil
+}
+
+func (s *CPSubsystem) newCPProxy(ctx context.Context, serviceName string, name string) (*cpProxy, error) {
+	if atomic.LoadInt32(&s.client.state) != ready {
+		return nil, hzerrors.ErrClientNotActive
+	}
+	proxyName, objectName, err := parseCPObjectName(name)
+	if err != nil {
+		return nil, err
+	}
+	pm := s.client.proxyManager
+	p, err := newProxy(ctx, pm.serviceBundle, serviceName, objectName, pm.refIDGenerator, func() bool { return true }, false)
+	if err != nil {
+		return nil, err
+	}
+	cp := &cpProxy{proxy: p, targets: s.targets, proxyName: proxyName}
+	request := codec.EncodeCPGroupCreateCPGroupRequest(proxyName)
+	response, err := cp.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	cp.groupID = codec.DecodeCPGroupCreateCPGroupResponse(response)
+	return cp, nil
+}
+
+// parseCPObjectName splits the given name of a CP data structure to the proxy name and the object name.
+// The proxy name does not contain the default group name, the object name does not contain a group name.
+func parseCPObjectName(name string) (proxyName string, objectName string, err error) {
+	name = strings.TrimSpace(name)
+	i := strings.Index(name, "@")
+	if i == -1 {
+		if name == "" {
+			return "", "", ihzerrors.NewIllegalArgumentError("object name cannot be empty", nil)
+		}
+		return name, name, nil
+	}
+	if strings.Contains(name[i+1:], "@") {
+		return "", "", ihzerrors.NewIllegalArgumentError("custom CP group name must be specified at most once", nil)
+	}
+	objectName = strings.TrimSpace(name[:i])
+	groupName := strings.TrimSpace(name[i+1:])
+	if objectName == "" {
+		return "", "", ihzerrors.NewIllegalArgumentError("object name cannot be empty", nil)
+	}
+	if groupName == "" {
+		return "", "", ihzerrors.NewIllegalArgumentError("custom CP group name cannot be empty", nil)
+	}
+	if strings.EqualFold(groupName, metadataCPGroupName) {
+		return "", "", ihzerrors.NewIllegalArgumentError("CP data structures cannot run on the METADATA CP group", nil)
+	}
+	if strings.EqualFold(groupName, defaultCPGroupName) {
+		return objectName, objectName, nil
+	}
+	return fmt.Sprintf("%s@%s", objectName, groupName), objectName, nil
+}
+
+// cpGroupTargets keeps the addresses of the members which served the requests to CP groups.
+// The client does not know the leaders of the CP groups; the member which receives a request forwards it to the leader of the group.
+// Requests to a CP group are sent to the same member until a request to it fails.
+type cpGroupTargets struct {
+	mu      *sync.RWMutex
+	targets map[codec.RaftGroupId]pubcluster.Address
+}
+
+func newCPGroupTargets() *cpGroupTargets {
+	return &cpGroupTargets{
+		mu:      &sync.RWMutex{},
+		targets: map[codec.RaftGroupId]pubcluster.Address{},
+	}
+}
+
+func (t *cpGroupTargets) get(groupID codec.RaftGroupId) (pubcluster.Address, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	addr, ok := t.targets[groupID]
+	return addr, ok
+}
+
+func (t *cpGroupTargets) set(groupID codec.RaftGroupId, addr pubcluster.Address) {
+	t.mu.Lock()
+	t.targets[groupID] = addr
+	t.mu.Unlock()
+}
+
+// remove removes the target of the given group, if it is still the given address.
+func (t *cpGroupTargets) remove(groupID codec.RaftGroupId, addr pubcluster.Address) {
+	t.mu.Lock()
+	if t.targets[groupID] == addr {
+		delete(t.targets, groupID)
+	}
+	t.mu.Unlock()
+}
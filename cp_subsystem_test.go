@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
+func TestParseCPObjectName(t *testing.T) {
+	testCases := []struct {
+		name       string
+		proxyName  string
+		objectName string
+	}{
+		{name: "counter", proxyName: "counter", objectName: "counter"},
+		{name: " counter ", proxyName: "counter", objectName: "counter"},
+		{name: "counter@group1", proxyName: "counter@group1", objectName: "counter"},
+		{name: "counter @ group1", proxyName: "counter@group1", objectName: "counter"},
+		{name: "counter@default", proxyName: "counter", objectName: "counter"},
+		{name: "counter@DEFAULT", proxyName: "counter", objectName: "counter"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proxyName, objectName, err := hz.ParseCPObjectName(tc.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.proxyName, proxyName)
+			assert.Equal(t, tc.objectName, objectName)
+		})
+	}
+}
+
+func TestParseCPObjectName_Invalid(t *testing.T) {
+	names := []string{"", "  ", "@group1", "counter@", "counter@ ", "counter@group1@group2", "counter@metadata", "counter@METADATA"}
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := hz.ParseCPObjectName(name); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+				t.Fatalf("expected illegal argument error, got: %v", err)
+			}
+		})
+	}
+}
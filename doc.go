@@ -180,6 +180,7 @@ Hazelcast Management Center can monitor your clients if client-side statistics a
 
 You can enable statistics by setting config.Stats.Enabled to true.
 Optionally, the period of statistics collection can be set using config.Stats.Period setting.
+The statistics include the hit, miss, eviction and invalidation counts of Near Caches, see the nearcache package for configuring them.
 The labels set in configuration appear in the Management Center console:
 
 	config := hazelcast.Config{}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestDurableExecutorService_Shutdown(t *testing.T) {
+	durableExecutorServiceTester(t, func(t *testing.T, d *hz.DurableExecutorService) {
+		ctx := context.Background()
+		assert.Equal(t, false, it.MustValue(d.IsShutdown(ctx)))
+		it.Must(d.Shutdown(ctx))
+		assert.Equal(t, true, it.MustValue(d.IsShutdown(ctx)))
+	})
+}
+
+func TestDurableExecutorService_SubmitInvalidTask(t *testing.T) {
+	durableExecutorServiceTester(t, func(t *testing.T, d *hz.DurableExecutorService) {
+		ctx := context.Background()
+		if _, err := d.Submit(ctx, "not-a-callable"); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+		if _, err := d.SubmitToKeyOwner(ctx, "not-a-callable", "key"); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func durableExecutorServiceTester(t *testing.T, f func(t *testing.T, d *hz.DurableExecutorService)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		d, err := client.GetDurableExecutorService(ctx, it.NewUniqueObjectName("durable-executor"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := d.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy durable executor service: %s", err.Error())
+			}
+		}()
+		f(t, d)
+	})
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestMap_EventJournal(t *testing.T) {
+	eventJournalMapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		partitionID := it.MustValue(hz.PartitionIDForKey(m, "key")).(int32)
+		state := it.MustValue(m.SubscribeToEventJournal(ctx, partitionID)).(hz.EventJournalState)
+		it.Must(m.Set(ctx, "key", "value-1"))
+		it.Must(m.Set(ctx, "key", "value-2"))
+		it.MustValue(m.Remove(ctx, "key"))
+		result, err := m.ReadFromEventJournal(ctx, partitionID, state.NewestSequence+1, 3, 10, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 3, result.ReadCount)
+		assert.Equal(t, state.NewestSequence+4, result.NextSequence)
+		target := []hz.EventJournalMapEvent{
+			{Key: "key", NewValue: "value-1", Sequence: state.NewestSequence + 1, EventType: hz.EventJournalInserted},
+			{Key: "key", NewValue: "value-2", OldValue: "value-1", Sequence: state.NewestSequence + 2, EventType: hz.EventJournalUpdated},
+			{Key: "key", OldValue: "value-2", Sequence: state.NewestSequence + 3, EventType: hz.EventJournalRemoved},
+		}
+		assert.Equal(t, target, result.Events)
+	})
+}
+
+func TestMap_EventJournalReplay(t *testing.T) {
+	eventJournalMapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		partitionID := it.MustValue(hz.PartitionIDForKey(m, "key")).(int32)
+		for i := 0; i < 5; i++ {
+			it.Must(m.Set(ctx, "key", i))
+		}
+		state := it.MustValue(m.SubscribeToEventJournal(ctx, partitionID)).(hz.EventJournalState)
+		// read the events in batches of 2, starting from the oldest one
+		var values []interface{}
+		seq := state.OldestSequence
+		for seq <= state.NewestSequence {
+			result, err := m.ReadFromEventJournal(ctx, partitionID, seq, 1, 2, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range result.Events {
+				values = append(values, e.NewValue)
+			}
+			seq = result.NextSequence
+		}
+		assert.Equal(t, []interface{}{int64(0), int64(1), int64(2), int64(3), int64(4)}, values)
+	})
+}
+
+func TestMap_EventJournalInvalidArguments(t *testing.T) {
+	eventJournalMapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		if _, err := m.SubscribeToEventJournal(ctx, -1); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+		if _, err := m.ReadFromEventJournal(ctx, 0, 0, 5, 1, nil, nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+		if _, err := m.ReadFromEventJournal(ctx, 0, 0, -1, 1, nil, nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+		x := int64(math.MaxInt32 + 1)
+		if _, err := m.ReadFromEventJournal(ctx, 0, 0, 1, int(x), nil, nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func eventJournalMapTester(t *testing.T, f func(t *testing.T, m *hz.Map)) {
+	makeName := func(labels ...string) string {
+		// the event journal is enabled for the maps with this prefix in the test cluster configuration
+		return it.NewUniqueObjectName("journal", labels...)
+	}
+	it.MapTesterWithConfigAndName(t, makeName, nil, f)
+}
@@ -59,6 +59,9 @@ const (
 	eventListItemNotified           = "list.itemnotified"
 	eventSetItemNotified            = "set.itemnotified"
 	eventDistributedObjectNotified  = "distributedobjectnotified"
+	eventCacheEntryNotified         = "cache.entrynotified"
+	eventPartitionLost              = "partitionlost"
+	eventMapPartitionLost           = "map.partitionlost"
 )
 
 // EntryNotified contains information about an entry event.
@@ -301,3 +304,97 @@ func newDistributedObjectNotified(service string, object string, eventType Distr
 		EventType:   eventType,
 	}
 }
+
+// CacheEntryEventType is the type of a cache entry event.
+type CacheEntryEventType int32
+
+const (
+	// CacheEntryCreated is dispatched if an entry is created.
+	CacheEntryCreated CacheEntryEventType = 1
+	// CacheEntryUpdated is dispatched if an entry is updated.
+	CacheEntryUpdated CacheEntryEventType = 2
+	// CacheEntryRemoved is dispatched if an entry is removed.
+	CacheEntryRemoved CacheEntryEventType = 3
+	// CacheEntryExpired is dispatched if an entry is expired.
+	CacheEntryExpired CacheEntryEventType = 4
+)
+
+// CacheEntryNotifiedHandler is called when a cache entry event happens.
+type CacheEntryNotifiedHandler func(event *CacheEntryNotified)
+
+// CacheEntryNotified contains information about a cache entry event.
+// OldValue is set only if the old value is available for the event.
+type CacheEntryNotified struct {
+	Key       interface{}
+	Value     interface{}
+	OldValue  interface{}
+	CacheName string
+	EventType CacheEntryEventType
+}
+
+func (e *CacheEntryNotified) EventName() string {
+	return eventCacheEntryNotified
+}
+
+func newCacheEntryNotified(cacheName string, key, value, oldValue interface{}, eventType CacheEntryEventType) *CacheEntryNotified {
+	return &CacheEntryNotified{
+		CacheName: cacheName,
+		Key:       key,
+		Value:     value,
+		OldValue:  oldValue,
+		EventType: eventType,
+	}
+}
+
+// PartitionLostHandler is called when a partition is lost.
+type PartitionLostHandler func(event *PartitionLost)
+
+// PartitionLost contains information about a partition lost event.
+// LostBackupCount is the index of the highest lost replica of the partition:
+// 0 means only the primary replica is lost, 1 means the primary replica and the first backup are lost, and so on.
+// Member is the member which detected the loss.
+// Member may have the zero value of cluster.MemberInfo if the member is not known at the time the corresponding callback runs.
+// You can check that situation by checking whether Member.UUID is the default UUID.
+type PartitionLost struct {
+	Member          cluster.MemberInfo
+	PartitionID     int32
+	LostBackupCount int
+}
+
+func (e *PartitionLost) EventName() string {
+	return eventPartitionLost
+}
+
+func newPartitionLost(partitionID int32, lostBackupCount int, member cluster.MemberInfo) *PartitionLost {
+	return &PartitionLost{
+		PartitionID:     partitionID,
+		LostBackupCount: lostBackupCount,
+		Member:          member,
+	}
+}
+
+// MapPartitionLostHandler is called when a partition which holds the entries of a Map is lost.
+type MapPartitionLostHandler func(event *MapPartitionLost)
+
+// MapPartitionLost contains information about a map partition lost event.
+// The event is dispatched if the primary replica and all backups of a partition of the map are lost.
+// Member is the member which detected the loss.
+// Member may have the zero value of cluster.MemberInfo if the member is not known at the time the corresponding callback runs.
+// You can check that situation by checking whether Member.UUID is the default UUID.
+type MapPartitionLost struct {
+	MapName     string
+	Member      cluster.MemberInfo
+	PartitionID int32
+}
+
+func (e *MapPartitionLost) EventName() string {
+	return eventMapPartitionLost
+}
+
+func newMapPartitionLost(mapName string, partitionID int32, member cluster.MemberInfo) *MapPartitionLost {
+	return &MapPartitionLost{
+		MapName:     mapName,
+		PartitionID: partitionID,
+		Member:      member,
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestExecutorService_Shutdown(t *testing.T) {
+	executorServiceTester(t, func(t *testing.T, e *hz.ExecutorService) {
+		ctx := context.Background()
+		assert.Equal(t, false, it.MustValue(e.IsShutdown(ctx)))
+		it.Must(e.Shutdown(ctx))
+		assert.Equal(t, true, it.MustValue(e.IsShutdown(ctx)))
+	})
+}
+
+func TestExecutorService_SubmitInvalidTask(t *testing.T) {
+	executorServiceTester(t, func(t *testing.T, e *hz.ExecutorService) {
+		ctx := context.Background()
+		if _, err := e.Submit(ctx, "not-a-callable"); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+		if _, err := e.SubmitToMembers(ctx, "not-a-callable", nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func TestExecutorService_SubmitToNoMembers(t *testing.T) {
+	executorServiceTester(t, func(t *testing.T, e *hz.ExecutorService) {
+		ctx := context.Background()
+		// the test cluster does not have lite members
+		_, err := e.SubmitToMembers(ctx, &SimpleEntryProcessor{}, cluster.LiteMemberSelector())
+		if !errors.Is(err, hzerrors.ErrRejectedExecution) {
+			t.Fatalf("expected rejected execution error, got: %v", err)
+		}
+	})
+}
+
+func executorServiceTester(t *testing.T, f func(t *testing.T, e *hz.ExecutorService)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		e, err := client.GetExecutorService(ctx, it.NewUniqueObjectName("executor"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := e.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy executor service: %s", err.Error())
+			}
+		}()
+		f(t, e)
+	})
+}
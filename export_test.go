@@ -19,6 +19,9 @@ package hazelcast
 import (
 	"context"
 	"time"
+
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
 
 // Exports non-exported types and methods to hazelcast_test package.
@@ -54,3 +57,45 @@ func NewFlakeIDBatch(base, increment int64, size int32, expiry time.Duration) fl
 func (f *flakeIDBatch) NextID() int64 {
 	return f.nextID()
 }
+
+func NewFuture(fn func() (interface{}, error)) *Future {
+	return newFuture(fn)
+}
+
+func ParseCPObjectName(name string) (proxyName string, objectName string, err error) {
+	return parseCPObjectName(name)
+}
+
+// LoseQueryCacheEvent removes the entry with the given key from the query cache and rewinds the sequence of its partition,
+// as if the last event of that partition was not received.
+func LoseQueryCacheEvent(qc *QueryCache, key interface{}) error {
+	keyData, err := qc.m.validateAndSerialize(key)
+	if err != nil {
+		return err
+	}
+	partitionID, err := qc.m.partitionService.GetPartitionID(keyData)
+	if err != nil {
+		return err
+	}
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+	delete(qc.records, recordKey(keyData))
+	qc.sequences[partitionID]--
+	return nil
+}
+
+func PartitionIDForKey(m *Map, key interface{}) (int32, error) {
+	keyData, err := m.validateAndSerialize(key)
+	if err != nil {
+		return 0, err
+	}
+	return m.partitionService.GetPartitionID(keyData)
+}
+
+func CacheExpiryPolicyData(policy CacheExpiryPolicy) (*iserialization.Data, error) {
+	ss, err := iserialization.NewService(&serialization.Config{})
+	if err != nil {
+		return nil, err
+	}
+	return cacheExpiryPolicyData(ss, policy)
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestFencedLock_LockUnlock(t *testing.T) {
+	fencedLockTester(t, "fenced-lock", func(t *testing.T, l *hz.FencedLock) {
+		lockCtx := l.NewLockContext(context.Background())
+		assert.Equal(t, false, it.MustValue(l.IsLocked(lockCtx)))
+		fence := it.MustValue(l.Lock(lockCtx)).(int64)
+		assert.NotEqual(t, hz.InvalidFence, fence)
+		assert.Equal(t, true, it.MustValue(l.IsLocked(lockCtx)))
+		it.Must(l.Unlock(lockCtx))
+		assert.Equal(t, false, it.MustValue(l.IsLocked(lockCtx)))
+	})
+}
+
+func TestFencedLock_Reentrant(t *testing.T) {
+	fencedLockTester(t, "fenced-lock", func(t *testing.T, l *hz.FencedLock) {
+		lockCtx := l.NewLockContext(context.Background())
+		fence1 := it.MustValue(l.Lock(lockCtx)).(int64)
+		fence2 := it.MustValue(l.Lock(lockCtx)).(int64)
+		// reentrant acquires return the same fencing token
+		assert.Equal(t, fence1, fence2)
+		it.Must(l.Unlock(lockCtx))
+		assert.Equal(t, true, it.MustValue(l.IsLocked(lockCtx)))
+		it.Must(l.Unlock(lockCtx))
+		assert.Equal(t, false, it.MustValue(l.IsLocked(lockCtx)))
+	})
+}
+
+func TestFencedLock_TryLock(t *testing.T) {
+	fencedLockTester(t, "fenced-lock", func(t *testing.T, l *hz.FencedLock) {
+		ctx := context.Background()
+		lockCtx1 := l.NewLockContext(ctx)
+		lockCtx2 := l.NewLockContext(ctx)
+		fence1 := it.MustValue(l.TryLock(lockCtx1)).(int64)
+		assert.NotEqual(t, hz.InvalidFence, fence1)
+		assert.Equal(t, hz.InvalidFence, it.MustValue(l.TryLock(lockCtx2)))
+		assert.Equal(t, hz.InvalidFence, it.MustValue(l.TryLockWithTimeout(lockCtx2, 100*time.Millisecond)))
+		it.Must(l.Unlock(lockCtx1))
+		fence2 := it.MustValue(l.TryLockWithTimeout(lockCtx2, 5*time.Second)).(int64)
+		// fencing tokens increase monotonically
+		assert.Greater(t, fence2, fence1)
+		it.Must(l.Unlock(lockCtx2))
+	})
+}
+
+func TestFencedLock_UnlockNotOwner(t *testing.T) {
+	fencedLockTester(t, "fenced-lock", func(t *testing.T, l *hz.FencedLock) {
+		ctx := context.Background()
+		lockCtx1 := l.NewLockContext(ctx)
+		lockCtx2 := l.NewLockContext(ctx)
+		if err := l.Unlock(lockCtx1); !errors.Is(err, hzerrors.ErrIllegalMonitorState) {
+			t.Fatalf("expected illegal monitor state error, got: %v", err)
+		}
+		it.MustValue(l.Lock(lockCtx1))
+		if err := l.Unlock(lockCtx2); !errors.Is(err, hzerrors.ErrIllegalMonitorState) {
+			t.Fatalf("expected illegal monitor state error, got: %v", err)
+		}
+		it.Must(l.Unlock(lockCtx1))
+	})
+}
+
+func TestFencedLock_LockBlocks(t *testing.T) {
+	fencedLockTester(t, "fenced-lock", func(t *testing.T, l *hz.FencedLock) {
+		ctx := context.Background()
+		lockCtx1 := l.NewLockContext(ctx)
+		lockCtx2 := l.NewLockContext(ctx)
+		it.MustValue(l.Lock(lockCtx1))
+		lockedCh := make(chan struct{})
+		go func() {
+			it.MustValue(l.Lock(lockCtx2))
+			close(lockedCh)
+		}()
+		select {
+		case <-lockedCh:
+			t.Fatalf("lock should not be acquired")
+		case <-time.After(500 * time.Millisecond):
+		}
+		it.Must(l.Unlock(lockCtx1))
+		select {
+		case <-lockedCh:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("lock should be acquired")
+		}
+		it.Must(l.Unlock(lockCtx2))
+	})
+}
+
+func fencedLockTester(t *testing.T, prefix string, f func(t *testing.T, l *hz.FencedLock)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		l, err := client.CPSubsystem().GetFencedLock(ctx, it.NewUniqueObjectName(prefix))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := l.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy fenced lock: %s", err.Error())
+			}
+		}()
+		f(t, l)
+	})
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import "context"
+
+// Future is the result of an asynchronous operation.
+type Future struct {
+	value  interface{}
+	err    error
+	doneCh chan struct{}
+}
+
+func newFuture(fn func() (interface{}, error)) *Future {
+	f := &Future{doneCh: make(chan struct{})}
+	go func() {
+		f.value, f.err = fn()
+		close(f.doneCh)
+	}()
+	return f
+}
+
+// Get waits for the operation to complete and returns its result.
+// Returns an error if the given context is done before the operation completes.
+func (f *Future) Get(ctx context.Context) (interface{}, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	select {
+	case <-f.doneCh:
+		return f.value, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Done returns a channel which is closed when the operation completes.
+func (f *Future) Done() <-chan struct{} {
+	return f.doneCh
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client"
+)
+
+func TestFuture_Get(t *testing.T) {
+	f := hazelcast.NewFuture(func() (interface{}, error) {
+		return "value", nil
+	})
+	<-f.Done()
+	v, err := f.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "value", v)
+	// the result is available for subsequent calls
+	v, err = f.Get(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "value", v)
+}
+
+func TestFuture_GetError(t *testing.T) {
+	targetErr := errors.New("failed")
+	f := hazelcast.NewFuture(func() (interface{}, error) {
+		return nil, targetErr
+	})
+	_, err := f.Get(context.Background())
+	assert.Equal(t, targetErr, err)
+}
+
+func TestFuture_GetContextDone(t *testing.T) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	f := hazelcast.NewFuture(func() (interface{}, error) {
+		<-doneCh
+		return nil, nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := f.Get(ctx)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
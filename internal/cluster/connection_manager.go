@@ -275,6 +275,11 @@ func (m *ConnectionManager) Stop() {
 	m.connMap.CloseAll(nil)
 }
 
+// ClientUUID returns the UUID this client uses to authenticate to the members.
+func (m *ConnectionManager) ClientUUID() types.UUID {
+	return m.clientUUID
+}
+
 func (m *ConnectionManager) NextConnectionID() int64 {
 	return atomic.AddInt64(&m.nextConnID, 1)
 }
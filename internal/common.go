@@ -18,8 +18,13 @@ package internal
 
 const (
 	// ClientType is used in the Management
-	ClientType         = "GOO"
-	AggregateFactoryID = -29
+	ClientType          = "GOO"
+	AggregateFactoryID  = -29
+	ProjectionFactoryID = -30
+	MapFactoryID        = -10
+	TopicFactoryID      = -9
+	CacheFactoryID      = -25
+	ClusterFactoryID    = 0
 	// ClientVersion should be manually set
 	ClientVersion = "1.1.1"
 )
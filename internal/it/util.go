@@ -356,6 +356,14 @@ func xmlConfig(clusterName string, port int) string {
 					<class-name>com.hazelcast.client.test.SampleMapStore</class-name>
 				</map-store>
 			</map>
+			<map name="test-journal*">
+				<event-journal enabled="true">
+					<capacity>10000</capacity>
+				</event-journal>
+			</map>
+			<cache name="test-cache*">
+				<statistics-enabled>true</statistics-enabled>
+			</cache>
 			<serialization>
 				<data-serializable-factories>
 					<data-serializable-factory factory-id="66">com.hazelcast.client.test.IdentifiedFactory</data-serializable-factory>
@@ -392,6 +400,14 @@ func xmlSSLConfig(clusterName string, port int) string {
 					<class-name>com.hazelcast.client.test.SampleMapStore</class-name>
 				</map-store>
 			</map>
+			<map name="test-journal*">
+				<event-journal enabled="true">
+					<capacity>10000</capacity>
+				</event-journal>
+			</map>
+			<cache name="test-cache*">
+				<statistics-enabled>true</statistics-enabled>
+			</cache>
 			<serialization>
 				<data-serializable-factories>
 					<data-serializable-factory factory-id="66">com.hazelcast.client.test.IdentifiedFactory</data-serializable-factory>
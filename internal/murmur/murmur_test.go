@@ -49,3 +49,27 @@ func TestMurmur3A(t *testing.T) {
 		}
 	}
 }
+
+func TestMurmur3X64(t *testing.T) {
+	testCases := []struct {
+		key      string
+		expected int64
+	}{
+		{"", 9168145165656307917},
+		{"key-1", -1733864005987780522},
+		{"hazelcast", -5410766897535987873},
+		{"\xff\xfe\x80abc", 6734606965456398709},
+		{"0123456789abcdef", -8662831058301528006},
+		{"0123456789abcdef\xf0\x9f\x98\x80xyz-long-key", -54835181404413482},
+	}
+	for _, tc := range testCases {
+		if hash := Default3X64([]byte(tc.key), 0, len(tc.key)); hash != tc.expected {
+			t.Errorf("Expected %d but was %d for Murmur3X64 of %q", tc.expected, hash, tc.key)
+		}
+	}
+	// the hash must only depend on the bytes in the given range
+	key := []byte("xxkey-1xx")
+	if hash := Default3X64(key, 2, 5); hash != -1733864005987780522 {
+		t.Errorf("Expected %d but was %d for Murmur3X64 with offset", int64(-1733864005987780522), hash)
+	}
+}
@@ -0,0 +1,348 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	pubnearcache "github.com/hazelcast/hazelcast-go-client/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	defaultReconciliationInterval = 60 * time.Second
+	defaultMaxToleratedMissCount  = 10
+	missCheckInterval             = 1 * time.Second
+)
+
+type ManagerCreationBundle struct {
+	SerializationService *iserialization.Service
+	PartitionService     *cluster.PartitionService
+	ClusterService       *cluster.Service
+	InvocationService    *invocation.Service
+	InvocationFactory    *cluster.ConnectionInvocationFactory
+	Logger               ilogger.Logger
+	ClientUUID           types.UUID
+}
+
+func (b ManagerCreationBundle) Check() {
+	if b.SerializationService == nil {
+		panic("SerializationService is nil")
+	}
+	if b.PartitionService == nil {
+		panic("PartitionService is nil")
+	}
+	if b.ClusterService == nil {
+		panic("ClusterService is nil")
+	}
+	if b.InvocationService == nil {
+		panic("InvocationService is nil")
+	}
+	if b.InvocationFactory == nil {
+		panic("InvocationFactory is nil")
+	}
+	if b.Logger == nil {
+		panic("Logger is nil")
+	}
+}
+
+// Manager creates the Near Caches and periodically repairs their invalidation metadata.
+type Manager struct {
+	ss                     *iserialization.Service
+	partitionService       *cluster.PartitionService
+	clusterService         *cluster.Service
+	invocationService      *invocation.Service
+	invocationFactory      *cluster.ConnectionInvocationFactory
+	logger                 ilogger.Logger
+	nearCaches             map[string]*NearCache
+	mu                     *sync.Mutex
+	doneCh                 chan struct{}
+	reconciliationInterval time.Duration
+	maxToleratedMissCount  int64
+	clientUUID             types.UUID
+	started                bool
+	stopped                bool
+}
+
+func NewManager(bundle ManagerCreationBundle) *Manager {
+	bundle.Check()
+	return &Manager{
+		ss:                     bundle.SerializationService,
+		partitionService:       bundle.PartitionService,
+		clusterService:         bundle.ClusterService,
+		invocationService:      bundle.InvocationService,
+		invocationFactory:      bundle.InvocationFactory,
+		logger:                 bundle.Logger,
+		clientUUID:             bundle.ClientUUID,
+		nearCaches:             map[string]*NearCache{},
+		mu:                     &sync.Mutex{},
+		doneCh:                 make(chan struct{}),
+		reconciliationInterval: defaultReconciliationInterval,
+		maxToleratedMissCount:  defaultMaxToleratedMissCount,
+	}
+}
+
+// GetOrCreateNearCache returns the Near Cache of the service with the given name, creating it if necessary.
+// The Near Cache uses the invalidation metadata of the cluster to detect missed invalidations.
+func (m *Manager) GetOrCreateNearCache(ctx context.Context, serviceName, name string, cfg pubnearcache.Config) (*NearCache, error) {
+	key := makeNearCacheKey(serviceName, name)
+	m.mu.Lock()
+	nc, ok := m.nearCaches[key]
+	m.mu.Unlock()
+	if ok {
+		return nc, nil
+	}
+	// the metadata is fetched without holding the lock, so other Near Caches are not blocked by the network calls
+	nameData, err := m.ss.ToData(name)
+	if err != nil {
+		return nil, err
+	}
+	namePartitionID, err := m.partitionService.GetPartitionID(nameData)
+	if err != nil {
+		return nil, err
+	}
+	nc = m.newNearCache(name, cfg)
+	nc.handler = newRepairingHandler(nc, m.clientUUID, m.partitionService.PartitionCount(), namePartitionID, nc.partitionIDFn)
+	nc.store = newStore(cfg, nc.handler, nc.stats)
+	if err := m.initMetaData(ctx, []*RepairingHandler{nc.handler}); err != nil {
+		// the metadata is repaired by anti-entropy later, so this is not fatal
+		m.logger.Warnf("fetching Near Cache invalidation metadata for %s: %s", name, err.Error())
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.nearCaches[key]; ok {
+		// another caller created the Near Cache in the meantime
+		return existing, nil
+	}
+	m.nearCaches[key] = nc
+	if !m.started && !m.stopped {
+		m.started = true
+		go m.repairLoop()
+	}
+	return nc, nil
+}
+
+// GetOrCreateNonRepairingNearCache returns the Near Cache of the service with the given name, creating it if necessary.
+// The Near Cache relies only on the invalidation events and does not use the invalidation metadata.
+func (m *Manager) GetOrCreateNonRepairingNearCache(serviceName, name string, cfg pubnearcache.Config) *NearCache {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := makeNearCacheKey(serviceName, name)
+	if nc, ok := m.nearCaches[key]; ok {
+		return nc
+	}
+	nc := m.newNearCache(name, cfg)
+	nc.store = newStore(cfg, freshReadDetector{}, nc.stats)
+	m.nearCaches[key] = nc
+	return nc
+}
+
+// DestroyNearCache clears and removes the Near Cache of the service with the given name.
+func (m *Manager) DestroyNearCache(serviceName, name string) {
+	key := makeNearCacheKey(serviceName, name)
+	m.mu.Lock()
+	nc, ok := m.nearCaches[key]
+	delete(m.nearCaches, key)
+	m.mu.Unlock()
+	if ok {
+		nc.Clear()
+	}
+}
+
+// Stats returns the statistics of all Near Caches.
+func (m *Manager) Stats() []Stats {
+	ncs := m.snapshot()
+	stats := make([]Stats, len(ncs))
+	for i, nc := range ncs {
+		stats[i] = nc.Stats()
+	}
+	return stats
+}
+
+// Stop stops the repair task and clears all Near Caches.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	if m.stopped {
+		m.mu.Unlock()
+		return
+	}
+	m.stopped = true
+	close(m.doneCh)
+	ncs := m.nearCaches
+	m.nearCaches = map[string]*NearCache{}
+	m.mu.Unlock()
+	for _, nc := range ncs {
+		nc.Clear()
+	}
+}
+
+func (m *Manager) newNearCache(name string, cfg pubnearcache.Config) *NearCache {
+	return &NearCache{
+		ss:            m.ss,
+		stats:         newStats(name),
+		partitionIDFn: m.partitionService.GetPartitionID,
+		name:          name,
+		format:        cfg.InMemoryFormat,
+	}
+}
+
+func (m *Manager) repairLoop() {
+	missTicker := time.NewTicker(missCheckInterval)
+	defer missTicker.Stop()
+	lastReconciliation := time.Now()
+	for {
+		select {
+		case <-m.doneCh:
+			return
+		case <-missTicker.C:
+			m.fixSequenceGaps()
+			if time.Since(lastReconciliation) >= m.reconciliationInterval {
+				m.runAntiEntropy()
+				lastReconciliation = time.Now()
+			}
+		}
+	}
+}
+
+// fixSequenceGaps marks the possibly stale records if too many invalidations were missed.
+func (m *Manager) fixSequenceGaps() {
+	for _, nc := range m.repairingSnapshot() {
+		if nc.handler.MissedSequenceCount() > m.maxToleratedMissCount {
+			nc.handler.UpdateLastKnownStaleSequences()
+		}
+	}
+}
+
+// runAntiEntropy fetches the invalidation metadata from the cluster and repairs the local metadata with it.
+func (m *Manager) runAntiEntropy() {
+	ncs := m.repairingSnapshot()
+	if len(ncs) == 0 {
+		return
+	}
+	handlers := make(map[string]*RepairingHandler, len(ncs))
+	for _, nc := range ncs {
+		handlers[nc.name] = nc.handler
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), m.reconciliationInterval)
+	defer cancel()
+	err := m.fetchMetaData(ctx, handlers, func(h *RepairingHandler, partitionID int32, sequence int64) {
+		h.CheckOrRepairSequence(partitionID, sequence, true)
+	}, func(h *RepairingHandler, partitionID int32, uuid types.UUID) {
+		h.CheckOrRepairUUID(partitionID, uuid)
+	})
+	if err != nil {
+		m.logger.Debug(func() string {
+			return fmt.Sprintf("running Near Cache anti-entropy: %s", err.Error())
+		})
+	}
+}
+
+func (m *Manager) initMetaData(ctx context.Context, hs []*RepairingHandler) error {
+	handlers := make(map[string]*RepairingHandler, len(hs))
+	for _, h := range hs {
+		handlers[h.Name()] = h
+	}
+	return m.fetchMetaData(ctx, handlers, func(h *RepairingHandler, partitionID int32, sequence int64) {
+		h.InitSequence(partitionID, sequence)
+	}, func(h *RepairingHandler, partitionID int32, uuid types.UUID) {
+		h.InitUUID(partitionID, uuid)
+	})
+}
+
+// fetchMetaData fetches the invalidation metadata from all data members and passes them to the given functions.
+func (m *Manager) fetchMetaData(
+	ctx context.Context,
+	handlers map[string]*RepairingHandler,
+	seqFn func(h *RepairingHandler, partitionID int32, sequence int64),
+	uuidFn func(h *RepairingHandler, partitionID int32, uuid types.UUID),
+) error {
+	names := make([]string, 0, len(handlers))
+	for name := range handlers {
+		names = append(names, name)
+	}
+	var lastErr error
+	for _, mem := range m.clusterService.OrderedMembers() {
+		if mem.LiteMember {
+			continue
+		}
+		resp, err := m.fetchMetaDataFromMember(ctx, names, mem)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		nameSeqs, partitionUUIDs := codec.DecodeMapFetchNearCacheInvalidationMetadataResponse(resp)
+		for _, p := range nameSeqs {
+			h, ok := handlers[p.Key().(string)]
+			if !ok {
+				continue
+			}
+			for _, ps := range p.Value().([]proto.Pair) {
+				seqFn(h, ps.Key().(int32), ps.Value().(int64))
+			}
+		}
+		for _, p := range partitionUUIDs {
+			for _, h := range handlers {
+				uuidFn(h, p.Key().(int32), p.Value().(types.UUID))
+			}
+		}
+	}
+	return lastErr
+}
+
+func (m *Manager) fetchMetaDataFromMember(ctx context.Context, names []string, mem pubcluster.MemberInfo) (*proto.ClientMessage, error) {
+	req := codec.EncodeMapFetchNearCacheInvalidationMetadataRequest(names, mem.UUID)
+	inv := m.invocationFactory.NewMemberBoundInvocation(req, &mem, time.Now())
+	if err := m.invocationService.SendRequest(ctx, inv); err != nil {
+		return nil, err
+	}
+	return inv.GetWithContext(ctx)
+}
+
+func (m *Manager) snapshot() []*NearCache {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ncs := make([]*NearCache, 0, len(m.nearCaches))
+	for _, nc := range m.nearCaches {
+		ncs = append(ncs, nc)
+	}
+	return ncs
+}
+
+func (m *Manager) repairingSnapshot() []*NearCache {
+	ncs := m.snapshot()
+	repairing := ncs[:0]
+	for _, nc := range ncs {
+		if nc.handler != nil {
+			repairing = append(repairing, nc)
+		}
+	}
+	return repairing
+}
+
+func makeNearCacheKey(serviceName, name string) string {
+	return fmt.Sprintf("%s:%s", serviceName, name)
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	pubnearcache "github.com/hazelcast/hazelcast-go-client/nearcache"
+)
+
+// NearCache keeps the recently read entries of a data structure in the client memory.
+type NearCache struct {
+	ss            *iserialization.Service
+	store         *store
+	handler       *RepairingHandler
+	stats         *Stats
+	partitionIDFn func(keyData *iserialization.Data) (int32, error)
+	name          string
+	format        pubnearcache.InMemoryFormat
+}
+
+func (nc *NearCache) Name() string {
+	return nc.name
+}
+
+// Get returns the deserialized value for the given key.
+// Returns false if there is no value for the given key.
+func (nc *NearCache) Get(keyData *iserialization.Data) (interface{}, bool, error) {
+	value, ok := nc.store.Get(makeKey(keyData))
+	if !ok {
+		return nil, false, nil
+	}
+	if nc.format == pubnearcache.InMemoryFormatObject {
+		return value, true, nil
+	}
+	obj, err := nc.ss.ToObject(value.(*iserialization.Data))
+	if err != nil {
+		return nil, false, err
+	}
+	return obj, true, nil
+}
+
+// TryReserveForUpdate reserves a record for the given key, so its value can be fetched and published.
+// Returns false if the key has a record or the Near Cache is full and nothing could be evicted.
+func (nc *NearCache) TryReserveForUpdate(keyData *iserialization.Data) (int64, bool) {
+	partitionID, err := nc.partitionIDFn(keyData)
+	if err != nil {
+		return 0, false
+	}
+	return nc.store.TryReserveForUpdate(makeKey(keyData), partitionID)
+}
+
+// TryPublishReserved sets the value of a reserved record and returns the deserialized value.
+// The value is not cached if the record was invalidated after it was reserved.
+func (nc *NearCache) TryPublishReserved(keyData, valueData *iserialization.Data, reservationID int64) (interface{}, error) {
+	key := makeKey(keyData)
+	obj, err := nc.ss.ToObject(valueData)
+	if err != nil {
+		nc.store.CancelReservation(key, reservationID)
+		return nil, err
+	}
+	var value interface{}
+	if valueData != nil {
+		if nc.format == pubnearcache.InMemoryFormatObject {
+			value = obj
+		} else {
+			value = valueData
+		}
+	}
+	nc.store.TryPublishReserved(key, value, reservationID)
+	return obj, nil
+}
+
+// CancelReservation removes the record reserved with the given reservation ID.
+func (nc *NearCache) CancelReservation(keyData *iserialization.Data, reservationID int64) {
+	nc.store.CancelReservation(makeKey(keyData), reservationID)
+}
+
+// Invalidate removes the value for the given key.
+func (nc *NearCache) Invalidate(keyData *iserialization.Data) {
+	nc.store.Invalidate(makeKey(keyData))
+}
+
+// Clear removes all values.
+func (nc *NearCache) Clear() {
+	nc.store.Clear()
+}
+
+// Size returns the number of entries in the Near Cache.
+func (nc *NearCache) Size() int {
+	return nc.store.Size()
+}
+
+// Stats returns a snapshot of the Near Cache statistics.
+func (nc *NearCache) Stats() Stats {
+	return nc.stats.Snapshot()
+}
+
+// RepairingHandler returns the handler which applies the invalidation events to this Near Cache.
+// Returns nil if the Near Cache does not use invalidation metadata.
+func (nc *NearCache) RepairingHandler() *RepairingHandler {
+	return nc.handler
+}
+
+func makeKey(keyData *iserialization.Data) string {
+	return string(keyData.ToByteArray())
+}
@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"sync"
+
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// MetaDataContainer contains the invalidation metadata of a partition.
+type MetaDataContainer struct {
+	mu *sync.Mutex
+	// uuid is the partition UUID, which changes when the partition owner loses its data.
+	uuid types.UUID
+	// sequence is the last received invalidation sequence.
+	sequence int64
+	// staleSequence is the sequence before which the records are considered stale.
+	staleSequence int64
+	// missedSequenceCount is the number of invalidation events which were detected as missing.
+	missedSequenceCount int64
+}
+
+func newMetaDataContainer() *MetaDataContainer {
+	return &MetaDataContainer{mu: &sync.Mutex{}}
+}
+
+func (c *MetaDataContainer) UUID() types.UUID {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.uuid
+}
+
+func (c *MetaDataContainer) Sequence() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sequence
+}
+
+func (c *MetaDataContainer) StaleSequence() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.staleSequence
+}
+
+func (c *MetaDataContainer) MissedSequenceCount() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.missedSequenceCount
+}
+
+// RepairingHandler applies the invalidation events to a Near Cache and keeps track of the invalidation metadata.
+// The metadata is used to detect missed invalidation events, so possibly stale records are not returned.
+type RepairingHandler struct {
+	nc              *NearCache
+	partitionIDFn   func(keyData *iserialization.Data) (int32, error)
+	containers      []*MetaDataContainer
+	localUUID       types.UUID
+	namePartitionID int32
+}
+
+func newRepairingHandler(nc *NearCache, localUUID types.UUID, partitionCount int32, namePartitionID int32, partitionIDFn func(keyData *iserialization.Data) (int32, error)) *RepairingHandler {
+	containers := make([]*MetaDataContainer, partitionCount)
+	for i := range containers {
+		containers[i] = newMetaDataContainer()
+	}
+	return &RepairingHandler{
+		nc:              nc,
+		localUUID:       localUUID,
+		partitionIDFn:   partitionIDFn,
+		containers:      containers,
+		namePartitionID: namePartitionID,
+	}
+}
+
+// Name returns the name of the Near Cache this handler belongs to.
+func (h *RepairingHandler) Name() string {
+	return h.nc.name
+}
+
+// Handle handles a single invalidation event.
+// A nil key means all entries of the Near Cache must be invalidated.
+func (h *RepairingHandler) Handle(keyData *iserialization.Data, sourceUUID, partitionUUID types.UUID, sequence int64) {
+	h.nc.stats.incrementInvalidationRequests()
+	// the entries updated by this client were already invalidated locally
+	if sourceUUID != h.localUUID {
+		if keyData != nil {
+			h.nc.Invalidate(keyData)
+		} else {
+			h.nc.Clear()
+		}
+	}
+	partitionID := h.partitionIDOrDefault(keyData)
+	h.CheckOrRepairUUID(partitionID, partitionUUID)
+	h.CheckOrRepairSequence(partitionID, sequence, false)
+}
+
+// HandleBatch handles a batch of invalidation events.
+func (h *RepairingHandler) HandleBatch(keys []*iserialization.Data, sourceUUIDs, partitionUUIDs []types.UUID, sequences []int64) {
+	for i, key := range keys {
+		h.Handle(key, sourceUUIDs[i], partitionUUIDs[i], sequences[i])
+	}
+}
+
+// CheckOrRepairUUID resets the metadata of the partition if the partition UUID has changed.
+func (h *RepairingHandler) CheckOrRepairUUID(partitionID int32, uuid types.UUID) {
+	c, ok := h.container(partitionID)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.uuid == uuid {
+		return
+	}
+	c.uuid = uuid
+	c.sequence = 0
+	c.staleSequence = 0
+}
+
+// CheckOrRepairSequence updates the last received sequence of the partition and records the missed sequences.
+// If the sequence is received via anti-entropy, the sequence was never observed through an invalidation event.
+func (h *RepairingHandler) CheckOrRepairSequence(partitionID int32, nextSequence int64, viaAntiEntropy bool) {
+	c, ok := h.container(partitionID)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.sequence >= nextSequence {
+		return
+	}
+	diff := nextSequence - c.sequence
+	c.sequence = nextSequence
+	if viaAntiEntropy {
+		c.missedSequenceCount += diff
+	} else if diff > 1 {
+		c.missedSequenceCount += diff - 1
+	}
+}
+
+// InitUUID sets the initial partition UUID.
+func (h *RepairingHandler) InitUUID(partitionID int32, uuid types.UUID) {
+	if c, ok := h.container(partitionID); ok {
+		c.mu.Lock()
+		c.uuid = uuid
+		c.mu.Unlock()
+	}
+}
+
+// InitSequence sets the initial sequence of the partition.
+func (h *RepairingHandler) InitSequence(partitionID int32, sequence int64) {
+	if c, ok := h.container(partitionID); ok {
+		c.mu.Lock()
+		c.sequence = sequence
+		c.mu.Unlock()
+	}
+}
+
+// MissedSequenceCount returns the total number of missed sequences over all partitions.
+func (h *RepairingHandler) MissedSequenceCount() int64 {
+	var total int64
+	for _, c := range h.containers {
+		total += c.MissedSequenceCount()
+	}
+	return total
+}
+
+// UpdateLastKnownStaleSequences marks the records of partitions with missed sequences as stale.
+func (h *RepairingHandler) UpdateLastKnownStaleSequences() {
+	for _, c := range h.containers {
+		c.mu.Lock()
+		if c.missedSequenceCount != 0 {
+			c.missedSequenceCount = 0
+			if c.staleSequence < c.sequence {
+				c.staleSequence = c.sequence
+			}
+		}
+		c.mu.Unlock()
+	}
+}
+
+// MetaDataContainer returns the metadata container of the given partition.
+func (h *RepairingHandler) MetaDataContainer(partitionID int32) *MetaDataContainer {
+	c, _ := h.container(partitionID)
+	return c
+}
+
+// IsStaleRead returns true if the record was created before an invalidation which was missed.
+func (h *RepairingHandler) IsStaleRead(rec *record) bool {
+	c, ok := h.container(rec.partitionID)
+	if !ok {
+		return true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return rec.uuid != c.uuid || rec.invalidationSequence < c.staleSequence
+}
+
+// MetaData returns the partition UUID and the last received sequence of the given partition.
+func (h *RepairingHandler) MetaData(partitionID int32) (types.UUID, int64) {
+	c, ok := h.container(partitionID)
+	if !ok {
+		return types.UUID{}, 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.uuid, c.sequence
+}
+
+func (h *RepairingHandler) container(partitionID int32) (*MetaDataContainer, bool) {
+	if partitionID < 0 || int(partitionID) >= len(h.containers) {
+		return nil, false
+	}
+	return h.containers[partitionID], true
+}
+
+func (h *RepairingHandler) partitionIDOrDefault(keyData *iserialization.Data) int32 {
+	if keyData == nil {
+		// the event to clear all entries is sent with the partition of the Near Cache name
+		return h.namePartitionID
+	}
+	if id, err := h.partitionIDFn(keyData); err == nil {
+		return id
+	}
+	return h.namePartitionID
+}
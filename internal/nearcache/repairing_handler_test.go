@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	pubnearcache "github.com/hazelcast/hazelcast-go-client/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const testPartitionCount = 4
+
+func TestRepairingHandler_HandleInvalidatesKey(t *testing.T) {
+	nc := newTestNearCache(types.NewUUID())
+	key := iserialization.NewData([]byte("key"))
+	publishData(t, nc, key)
+	nc.handler.Handle(key, types.NewUUID(), types.UUID{}, 1)
+	_, ok := nc.store.Get(makeKey(key))
+	assert.False(t, ok)
+	assert.Equal(t, int64(1), nc.Stats().InvalidationRequests)
+}
+
+func TestRepairingHandler_HandleIgnoresLocalSource(t *testing.T) {
+	localUUID := types.NewUUID()
+	nc := newTestNearCache(localUUID)
+	key := iserialization.NewData([]byte("key"))
+	publishData(t, nc, key)
+	nc.handler.Handle(key, localUUID, types.UUID{}, 1)
+	_, ok := nc.store.Get(makeKey(key))
+	assert.True(t, ok)
+}
+
+func TestRepairingHandler_HandleNilKeyClears(t *testing.T) {
+	nc := newTestNearCache(types.NewUUID())
+	publishData(t, nc, iserialization.NewData([]byte("key1")))
+	publishData(t, nc, iserialization.NewData([]byte("key2")))
+	nc.handler.Handle(nil, types.NewUUID(), types.UUID{}, 1)
+	assert.Equal(t, 0, nc.Size())
+}
+
+func TestRepairingHandler_SequenceGaps(t *testing.T) {
+	nc := newTestNearCache(types.NewUUID())
+	h := nc.handler
+	h.CheckOrRepairSequence(0, 1, false)
+	assert.Equal(t, int64(0), h.MissedSequenceCount())
+	// sequences 2, 3 and 4 are missed
+	h.CheckOrRepairSequence(0, 5, false)
+	assert.Equal(t, int64(3), h.MissedSequenceCount())
+	// old sequences are ignored
+	h.CheckOrRepairSequence(0, 4, false)
+	assert.Equal(t, int64(5), h.MetaDataContainer(0).Sequence())
+	// all sequences reported by anti-entropy are considered as missed
+	h.CheckOrRepairSequence(0, 7, true)
+	assert.Equal(t, int64(5), h.MissedSequenceCount())
+	h.UpdateLastKnownStaleSequences()
+	assert.Equal(t, int64(0), h.MissedSequenceCount())
+	assert.Equal(t, int64(7), h.MetaDataContainer(0).StaleSequence())
+}
+
+func TestRepairingHandler_PartitionUUIDChange(t *testing.T) {
+	nc := newTestNearCache(types.NewUUID())
+	h := nc.handler
+	partitionUUID := types.NewUUID()
+	h.InitUUID(0, partitionUUID)
+	h.InitSequence(0, 10)
+	h.CheckOrRepairUUID(0, partitionUUID)
+	assert.Equal(t, int64(10), h.MetaDataContainer(0).Sequence())
+	newUUID := types.NewUUID()
+	h.CheckOrRepairUUID(0, newUUID)
+	assert.Equal(t, newUUID, h.MetaDataContainer(0).UUID())
+	assert.Equal(t, int64(0), h.MetaDataContainer(0).Sequence())
+}
+
+func TestRepairingHandler_StaleRead(t *testing.T) {
+	nc := newTestNearCache(types.NewUUID())
+	h := nc.handler
+	partitionUUID := types.NewUUID()
+	h.InitUUID(0, partitionUUID)
+	h.InitSequence(0, 3)
+	key := iserialization.NewData([]byte("key"))
+	publishData(t, nc, key)
+	_, ok := nc.store.Get(makeKey(key))
+	assert.True(t, ok)
+	// an invalidation was missed after the record was created
+	h.CheckOrRepairSequence(0, 5, false)
+	h.UpdateLastKnownStaleSequences()
+	_, ok = nc.store.Get(makeKey(key))
+	assert.False(t, ok)
+	// a partition UUID change also makes the records stale
+	publishData(t, nc, key)
+	h.CheckOrRepairUUID(0, types.NewUUID())
+	_, ok = nc.store.Get(makeKey(key))
+	assert.False(t, ok)
+}
+
+func newTestNearCache(localUUID types.UUID) *NearCache {
+	cfg := pubnearcache.Config{}
+	if err := cfg.Validate(); err != nil {
+		panic(err)
+	}
+	stats := newStats("test")
+	partitionIDFn := func(keyData *iserialization.Data) (int32, error) {
+		return 0, nil
+	}
+	nc := &NearCache{
+		stats:         stats,
+		partitionIDFn: partitionIDFn,
+		name:          "test",
+	}
+	nc.handler = newRepairingHandler(nc, localUUID, testPartitionCount, 0, partitionIDFn)
+	nc.store = newStore(cfg, nc.handler, stats)
+	return nc
+}
+
+func publishData(t *testing.T, nc *NearCache, keyData *iserialization.Data) {
+	id, ok := nc.TryReserveForUpdate(keyData)
+	if !ok {
+		t.Fatalf("could not reserve key")
+	}
+	if !nc.store.TryPublishReserved(makeKey(keyData), "value", id) {
+		t.Fatalf("could not publish key")
+	}
+}
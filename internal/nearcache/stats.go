@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Stats contains the statistics of a Near Cache.
+type Stats struct {
+	CreationTime         time.Time
+	Name                 string
+	OwnedEntryCount      int64
+	Hits                 int64
+	Misses               int64
+	Evictions            int64
+	Expirations          int64
+	Invalidations        int64
+	InvalidationRequests int64
+}
+
+func newStats(name string) *Stats {
+	return &Stats{
+		Name:         name,
+		CreationTime: time.Now(),
+	}
+}
+
+// Snapshot returns a copy of the statistics which is safe to read.
+func (s *Stats) Snapshot() Stats {
+	return Stats{
+		Name:                 s.Name,
+		CreationTime:         s.CreationTime,
+		OwnedEntryCount:      atomic.LoadInt64(&s.OwnedEntryCount),
+		Hits:                 atomic.LoadInt64(&s.Hits),
+		Misses:               atomic.LoadInt64(&s.Misses),
+		Evictions:            atomic.LoadInt64(&s.Evictions),
+		Expirations:          atomic.LoadInt64(&s.Expirations),
+		Invalidations:        atomic.LoadInt64(&s.Invalidations),
+		InvalidationRequests: atomic.LoadInt64(&s.InvalidationRequests),
+	}
+}
+
+func (s *Stats) setOwnedEntryCount(n int) {
+	atomic.StoreInt64(&s.OwnedEntryCount, int64(n))
+}
+
+func (s *Stats) incrementHits() {
+	atomic.AddInt64(&s.Hits, 1)
+}
+
+func (s *Stats) incrementMisses() {
+	atomic.AddInt64(&s.Misses, 1)
+}
+
+func (s *Stats) incrementEvictions() {
+	atomic.AddInt64(&s.Evictions, 1)
+}
+
+func (s *Stats) incrementExpirations() {
+	atomic.AddInt64(&s.Expirations, 1)
+}
+
+func (s *Stats) incrementInvalidations() {
+	atomic.AddInt64(&s.Invalidations, 1)
+}
+
+func (s *Stats) addInvalidations(n int64) {
+	atomic.AddInt64(&s.Invalidations, n)
+}
+
+func (s *Stats) incrementInvalidationRequests() {
+	atomic.AddInt64(&s.InvalidationRequests, 1)
+}
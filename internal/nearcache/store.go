@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"sync"
+	"time"
+
+	pubnearcache "github.com/hazelcast/hazelcast-go-client/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// readPermitted is the reservation ID of a record which is published and can be read.
+	readPermitted int64 = -1
+	// evictionSampleCount is the number of records sampled to find an eviction candidate.
+	evictionSampleCount = 15
+)
+
+type record struct {
+	value                interface{}
+	uuid                 types.UUID
+	creationTime         int64
+	expirationTime       int64
+	lastAccessTime       int64
+	invalidationSequence int64
+	reservationID        int64
+	hits                 int64
+	partitionID          int32
+}
+
+func (r *record) isExpired(now int64, maxIdle int64) bool {
+	if r.expirationTime > 0 && r.expirationTime <= now {
+		return true
+	}
+	if maxIdle > 0 && r.lastAccessTime+maxIdle <= now {
+		return true
+	}
+	return false
+}
+
+// staleReadDetector checks whether a record was invalidated while this client could not receive the invalidation events.
+type staleReadDetector interface {
+	IsStaleRead(rec *record) bool
+	MetaData(partitionID int32) (uuid types.UUID, sequence int64)
+}
+
+// freshReadDetector is used by the Near Caches which do not track invalidation metadata.
+type freshReadDetector struct{}
+
+func (d freshReadDetector) IsStaleRead(rec *record) bool {
+	return false
+}
+
+func (d freshReadDetector) MetaData(partitionID int32) (types.UUID, int64) {
+	return types.UUID{}, 0
+}
+
+// store keeps the records of a Near Cache.
+// Keys of the store are serialized keys, so the same key always maps to the same record regardless of its Go type.
+type store struct {
+	detector        staleReadDetector
+	mu              *sync.Mutex
+	records         map[string]*record
+	now             func() time.Time
+	stats           *Stats
+	nextReservation int64
+	ttl             int64
+	maxIdle         int64
+	maxSize         int
+	evictionPolicy  pubnearcache.EvictionPolicy
+}
+
+func newStore(cfg pubnearcache.Config, detector staleReadDetector, stats *Stats) *store {
+	return &store{
+		detector:       detector,
+		mu:             &sync.Mutex{},
+		records:        map[string]*record{},
+		now:            time.Now,
+		stats:          stats,
+		ttl:            time.Duration(cfg.TimeToLive).Milliseconds(),
+		maxIdle:        time.Duration(cfg.MaxIdle).Milliseconds(),
+		maxSize:        int(cfg.Eviction.Size),
+		evictionPolicy: cfg.Eviction.Policy,
+	}
+}
+
+// Get returns the value of the record with the given key.
+// Reserved, expired and stale records are not returned.
+func (s *store) Get(key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.records[key]
+	if !ok || rec.reservationID != readPermitted {
+		s.stats.incrementMisses()
+		return nil, false
+	}
+	now := s.nowMillis()
+	if rec.isExpired(now, s.maxIdle) {
+		s.removeRecord(key)
+		s.stats.incrementExpirations()
+		s.stats.incrementMisses()
+		return nil, false
+	}
+	if s.detector.IsStaleRead(rec) {
+		s.removeRecord(key)
+		s.stats.incrementInvalidations()
+		s.stats.incrementMisses()
+		return nil, false
+	}
+	rec.lastAccessTime = now
+	rec.hits++
+	s.stats.incrementHits()
+	return rec.value, true
+}
+
+// TryReserveForUpdate creates a reserved record for the given key if there is no record for it.
+// Returns the reservation ID and true if the reservation succeeded.
+func (s *store) TryReserveForUpdate(key string, partitionID int32) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.records[key]; ok {
+		return 0, false
+	}
+	if len(s.records) >= s.maxSize && !s.evict() {
+		return 0, false
+	}
+	s.nextReservation++
+	uuid, seq := s.detector.MetaData(partitionID)
+	now := s.nowMillis()
+	s.records[key] = &record{
+		reservationID:        s.nextReservation,
+		partitionID:          partitionID,
+		uuid:                 uuid,
+		invalidationSequence: seq,
+		creationTime:         now,
+		lastAccessTime:       now,
+	}
+	s.stats.setOwnedEntryCount(len(s.records))
+	return s.nextReservation, true
+}
+
+// TryPublishReserved sets the value of the record reserved with the given reservation ID.
+// Returns false if the record was invalidated after it was reserved.
+func (s *store) TryPublishReserved(key string, value interface{}, reservationID int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.records[key]
+	if !ok || rec.reservationID != reservationID {
+		return false
+	}
+	if value == nil {
+		// nil values are not cached
+		s.removeRecord(key)
+		return false
+	}
+	now := s.nowMillis()
+	rec.value = value
+	rec.reservationID = readPermitted
+	rec.creationTime = now
+	rec.lastAccessTime = now
+	if s.ttl > 0 {
+		rec.expirationTime = now + s.ttl
+	}
+	return true
+}
+
+// CancelReservation removes the record reserved with the given reservation ID.
+func (s *store) CancelReservation(key string, reservationID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if rec, ok := s.records[key]; ok && rec.reservationID == reservationID {
+		s.removeRecord(key)
+	}
+}
+
+// Invalidate removes the record with the given key.
+func (s *store) Invalidate(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.records[key]; ok {
+		s.removeRecord(key)
+		s.stats.incrementInvalidations()
+	}
+}
+
+// Clear removes all records.
+func (s *store) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.addInvalidations(int64(len(s.records)))
+	s.records = map[string]*record{}
+	s.stats.setOwnedEntryCount(0)
+}
+
+// Size returns the number of records, including the reserved ones.
+func (s *store) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.records)
+}
+
+// evict removes a record according to the eviction policy to make room for a new one.
+// This method should be called under lock.
+func (s *store) evict() bool {
+	if s.evictionPolicy == pubnearcache.EvictionPolicyNone {
+		return false
+	}
+	now := s.nowMillis()
+	var candidateKey string
+	var candidate *record
+	sampled := 0
+	// map iteration order is random, which makes the first records a random sample
+	for key, rec := range s.records {
+		if rec.reservationID != readPermitted {
+			continue
+		}
+		if rec.isExpired(now, s.maxIdle) {
+			// an expired record is always the best candidate
+			s.removeRecord(key)
+			s.stats.incrementExpirations()
+			return true
+		}
+		if candidate == nil || s.betterCandidate(rec, candidate) {
+			candidateKey = key
+			candidate = rec
+		}
+		sampled++
+		if sampled >= evictionSampleCount {
+			break
+		}
+	}
+	if candidate == nil {
+		return false
+	}
+	s.removeRecord(candidateKey)
+	s.stats.incrementEvictions()
+	return true
+}
+
+func (s *store) betterCandidate(rec, candidate *record) bool {
+	switch s.evictionPolicy {
+	case pubnearcache.EvictionPolicyLRU:
+		return rec.lastAccessTime < candidate.lastAccessTime
+	case pubnearcache.EvictionPolicyLFU:
+		return rec.hits < candidate.hits
+	}
+	return false
+}
+
+// removeRecord should be called under lock.
+func (s *store) removeRecord(key string) {
+	delete(s.records, key)
+	s.stats.setOwnedEntryCount(len(s.records))
+}
+
+func (s *store) nowMillis() int64 {
+	return s.now().UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pubnearcache "github.com/hazelcast/hazelcast-go-client/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestStore_GetPublished(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{})
+	_, ok := s.Get("k")
+	assert.False(t, ok)
+	id, ok := s.TryReserveForUpdate("k", 0)
+	assert.True(t, ok)
+	// reserved records are not returned
+	_, ok = s.Get("k")
+	assert.False(t, ok)
+	assert.True(t, s.TryPublishReserved("k", "v", id))
+	v, ok := s.Get("k")
+	assert.True(t, ok)
+	assert.Equal(t, "v", v)
+	st := s.stats.Snapshot()
+	assert.Equal(t, int64(1), st.Hits)
+	assert.Equal(t, int64(2), st.Misses)
+	assert.Equal(t, int64(1), st.OwnedEntryCount)
+}
+
+func TestStore_ReserveExisting(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{})
+	_, ok := s.TryReserveForUpdate("k", 0)
+	assert.True(t, ok)
+	_, ok = s.TryReserveForUpdate("k", 0)
+	assert.False(t, ok)
+}
+
+func TestStore_PublishNilValue(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{})
+	id, _ := s.TryReserveForUpdate("k", 0)
+	assert.False(t, s.TryPublishReserved("k", nil, id))
+	assert.Equal(t, 0, s.Size())
+}
+
+func TestStore_InvalidateReserved(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{})
+	id, _ := s.TryReserveForUpdate("k", 0)
+	s.Invalidate("k")
+	assert.False(t, s.TryPublishReserved("k", "v", id))
+	_, ok := s.Get("k")
+	assert.False(t, ok)
+}
+
+func TestStore_CancelReservation(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{})
+	id, _ := s.TryReserveForUpdate("k", 0)
+	s.CancelReservation("k", id+1)
+	assert.Equal(t, 1, s.Size())
+	s.CancelReservation("k", id)
+	assert.Equal(t, 0, s.Size())
+}
+
+func TestStore_TimeToLive(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{TimeToLive: types.Duration(10 * time.Second)})
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	publish(t, s, "k", "v")
+	now = now.Add(9 * time.Second)
+	_, ok := s.Get("k")
+	assert.True(t, ok)
+	now = now.Add(1 * time.Second)
+	_, ok = s.Get("k")
+	assert.False(t, ok)
+	assert.Equal(t, int64(1), s.stats.Snapshot().Expirations)
+	assert.Equal(t, 0, s.Size())
+}
+
+func TestStore_MaxIdle(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{MaxIdle: types.Duration(10 * time.Second)})
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	publish(t, s, "k", "v")
+	now = now.Add(9 * time.Second)
+	_, ok := s.Get("k")
+	assert.True(t, ok)
+	// the read above extends the lifetime of the record
+	now = now.Add(9 * time.Second)
+	_, ok = s.Get("k")
+	assert.True(t, ok)
+	now = now.Add(10 * time.Second)
+	_, ok = s.Get("k")
+	assert.False(t, ok)
+}
+
+func TestStore_EvictLRU(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{Eviction: pubnearcache.EvictionConfig{Size: 2}})
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	publish(t, s, "k1", "v1")
+	now = now.Add(time.Second)
+	publish(t, s, "k2", "v2")
+	now = now.Add(time.Second)
+	// k1 becomes the most recently used
+	_, ok := s.Get("k1")
+	assert.True(t, ok)
+	now = now.Add(time.Second)
+	publish(t, s, "k3", "v3")
+	_, ok = s.Get("k2")
+	assert.False(t, ok)
+	_, ok = s.Get("k1")
+	assert.True(t, ok)
+	_, ok = s.Get("k3")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), s.stats.Snapshot().Evictions)
+}
+
+func TestStore_EvictLFU(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{Eviction: pubnearcache.EvictionConfig{Policy: pubnearcache.EvictionPolicyLFU, Size: 2}})
+	publish(t, s, "k1", "v1")
+	publish(t, s, "k2", "v2")
+	for i := 0; i < 3; i++ {
+		s.Get("k2")
+	}
+	s.Get("k1")
+	publish(t, s, "k3", "v3")
+	_, ok := s.Get("k1")
+	assert.False(t, ok)
+	_, ok = s.Get("k2")
+	assert.True(t, ok)
+}
+
+func TestStore_EvictNone(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{Eviction: pubnearcache.EvictionConfig{Policy: pubnearcache.EvictionPolicyNone, Size: 1}})
+	publish(t, s, "k1", "v1")
+	_, ok := s.TryReserveForUpdate("k2", 0)
+	assert.False(t, ok)
+	_, ok = s.Get("k1")
+	assert.True(t, ok)
+}
+
+func TestStore_Clear(t *testing.T) {
+	s := newTestStore(pubnearcache.Config{})
+	publish(t, s, "k1", "v1")
+	publish(t, s, "k2", "v2")
+	s.Clear()
+	assert.Equal(t, 0, s.Size())
+	st := s.stats.Snapshot()
+	assert.Equal(t, int64(2), st.Invalidations)
+	assert.Equal(t, int64(0), st.OwnedEntryCount)
+}
+
+func newTestStore(cfg pubnearcache.Config) *store {
+	if err := cfg.Validate(); err != nil {
+		panic(err)
+	}
+	return newStore(cfg, freshReadDetector{}, newStats("test"))
+}
+
+func publish(t *testing.T, s *store, key string, value interface{}) {
+	id, ok := s.TryReserveForUpdate(key, 0)
+	if !ok {
+		t.Fatalf("could not reserve %s", key)
+	}
+	if !s.TryPublishReserved(key, value, id) {
+		t.Fatalf("could not publish %s", key)
+	}
+}
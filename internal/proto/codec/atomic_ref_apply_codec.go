@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x0A0100
+	AtomicRefApplyCodecRequestMessageType = int32(655616)
+	// hex: 0x0A0101
+	AtomicRefApplyCodecResponseMessageType = int32(655617)
+
+	AtomicRefApplyCodecRequestReturnValueTypeOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	AtomicRefApplyCodecRequestAlterOffset           = AtomicRefApplyCodecRequestReturnValueTypeOffset + proto.IntSizeInBytes
+	AtomicRefApplyCodecRequestInitialFrameSize      = AtomicRefApplyCodecRequestAlterOffset + proto.BooleanSizeInBytes
+)
+
+// Applies a function on the value
+
+func EncodeAtomicRefApplyRequest(groupId RaftGroupId, name string, function *iserialization.Data, returnValueType int32, alter bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, AtomicRefApplyCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, AtomicRefApplyCodecRequestReturnValueTypeOffset, returnValueType)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, AtomicRefApplyCodecRequestAlterOffset, alter)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(AtomicRefApplyCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeRaftGroupId(clientMessage, groupId)
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, function)
+
+	return clientMessage
+}
+
+func DecodeAtomicRefApplyResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
@@ -305,14 +305,15 @@ func EncodeEntryListUUIDLong(message *proto.ClientMessage, entries []proto.Pair)
 }
 
 func EncodeEntryListIntegerInteger(message *proto.ClientMessage, entries []proto.Pair) {
+	const entrySizeInBytes = proto.IntSizeInBytes + proto.IntSizeInBytes
 	size := len(entries)
-	content := make([]byte, size*proto.EntrySizeInBytes)
+	content := make([]byte, size*entrySizeInBytes)
 	newFrame := proto.NewFrame(content)
 	for i, entry := range entries {
 		key := entry.Key().(int32)
 		value := entry.Value().(int32)
-		FixSizedTypesCodec.EncodeInt(content, int32(i*proto.EntrySizeInBytes), key)
-		FixSizedTypesCodec.EncodeInt(content, int32(i*proto.EntrySizeInBytes+proto.UUIDSizeInBytes), value)
+		FixSizedTypesCodec.EncodeInt(content, int32(i*entrySizeInBytes), key)
+		FixSizedTypesCodec.EncodeInt(content, int32(i*entrySizeInBytes+proto.IntSizeInBytes), value)
 	}
 	message.AddFrame(newFrame)
 }
@@ -330,12 +331,13 @@ func DecodeEntryListUUIDLong(frameIterator *proto.ForwardFrameIterator) []proto.
 }
 
 func DecodeEntryListIntegerInteger(frameIterator *proto.ForwardFrameIterator) []proto.Pair {
+	const entrySizeInBytes = proto.IntSizeInBytes + proto.IntSizeInBytes
 	nextFrame := frameIterator.Next()
-	itemCount := len(nextFrame.Content) / proto.EntrySizeInBytes
+	itemCount := len(nextFrame.Content) / entrySizeInBytes
 	content := make([]proto.Pair, itemCount)
 	for i := 0; i < itemCount; i++ {
-		key := FixSizedTypesCodec.DecodeInt(nextFrame.Content, int32(i*proto.EntrySizeInBytes))
-		value := FixSizedTypesCodec.DecodeInt(nextFrame.Content, int32(i*proto.EntrySizeInBytes+proto.IntSizeInBytes))
+		key := FixSizedTypesCodec.DecodeInt(nextFrame.Content, int32(i*entrySizeInBytes))
+		value := FixSizedTypesCodec.DecodeInt(nextFrame.Content, int32(i*entrySizeInBytes+proto.IntSizeInBytes))
 		content[i] = proto.NewPair(key, value)
 	}
 	return content
@@ -368,24 +370,26 @@ func DecodeEntryListUUIDListInteger(frameIterator *proto.ForwardFrameIterator) [
 }
 
 func DecodeEntryListIntegerUUID(frameIterator *proto.ForwardFrameIterator) []proto.Pair {
+	const entrySizeInBytes = proto.IntSizeInBytes + proto.UUIDSizeInBytes
 	frame := frameIterator.Next()
-	entryCount := len(frame.Content) / proto.EntrySizeInBytes
+	entryCount := len(frame.Content) / entrySizeInBytes
 	result := make([]proto.Pair, entryCount)
 	for i := 0; i < entryCount; i++ {
-		key := FixSizedTypesCodec.DecodeInt(frame.Content, int32(i*proto.EntrySizeInBytes))
-		value := FixSizedTypesCodec.DecodeUUID(frame.Content, int32(i*proto.EntrySizeInBytes+proto.IntSizeInBytes))
+		key := FixSizedTypesCodec.DecodeInt(frame.Content, int32(i*entrySizeInBytes))
+		value := FixSizedTypesCodec.DecodeUUID(frame.Content, int32(i*entrySizeInBytes+proto.IntSizeInBytes))
 		result[i] = proto.NewPair(key, value)
 	}
 	return result
 }
 
 func DecodeEntryListIntegerLong(iterator *proto.ForwardFrameIterator) []proto.Pair {
+	const entrySizeInBytes = proto.IntSizeInBytes + proto.LongSizeInBytes
 	frame := iterator.Next()
-	entryCount := len(frame.Content) / proto.EntrySizeInBytes
+	entryCount := len(frame.Content) / entrySizeInBytes
 	result := make([]proto.Pair, entryCount)
 	for i := 0; i < entryCount; i++ {
-		key := FixSizedTypesCodec.DecodeInt(frame.Content, int32(i*proto.EntrySizeInBytes))
-		value := FixSizedTypesCodec.DecodeLong(frame.Content, int32(i*proto.EntrySizeInBytes+proto.IntSizeInBytes))
+		key := FixSizedTypesCodec.DecodeInt(frame.Content, int32(i*entrySizeInBytes))
+		value := FixSizedTypesCodec.DecodeLong(frame.Content, int32(i*entrySizeInBytes+proto.IntSizeInBytes))
 		result[i] = proto.NewPair(key, value)
 	}
 	return result
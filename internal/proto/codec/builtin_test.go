@@ -18,6 +18,7 @@ package codec
 
 import (
 	"encoding/binary"
+	"math"
 	"testing"
 
 	"github.com/hazelcast/hazelcast-go-client/types"
@@ -25,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 )
 
 func TestCodecUtil_FastForwardToEndFrame(t *testing.T) {
@@ -463,6 +465,44 @@ func TestEntryListUUIDLongCodec_Encode(t *testing.T) {
 	assert.Equal(t, frame.Value().(int64), value)
 }
 
+func TestEntryListIntegerUUIDCodec_Decode(t *testing.T) {
+	// given
+	const entrySize = proto.IntSizeInBytes + proto.UUIDSizeInBytes
+	uuid1 := types.NewUUID()
+	uuid2 := types.NewUUID()
+	content := make([]byte, 2*entrySize)
+	FixSizedTypesCodec.EncodeInt(content, 0, 1)
+	FixSizedTypesCodec.EncodeUUID(content, proto.IntSizeInBytes, uuid1)
+	FixSizedTypesCodec.EncodeInt(content, entrySize, 2)
+	FixSizedTypesCodec.EncodeUUID(content, entrySize+proto.IntSizeInBytes, uuid2)
+	message := proto.NewClientMessageForEncode()
+	message.AddFrame(proto.NewFrame(content))
+
+	// when
+	pairs := DecodeEntryListIntegerUUID(message.FrameIterator())
+
+	// then
+	assert.Equal(t, []proto.Pair{proto.NewPair(int32(1), uuid1), proto.NewPair(int32(2), uuid2)}, pairs)
+}
+
+func TestEntryListIntegerLongCodec_Decode(t *testing.T) {
+	// given
+	const entrySize = proto.IntSizeInBytes + proto.LongSizeInBytes
+	content := make([]byte, 2*entrySize)
+	FixSizedTypesCodec.EncodeInt(content, 0, 1)
+	FixSizedTypesCodec.EncodeLong(content, proto.IntSizeInBytes, 10)
+	FixSizedTypesCodec.EncodeInt(content, entrySize, 2)
+	FixSizedTypesCodec.EncodeLong(content, entrySize+proto.IntSizeInBytes, 20)
+	message := proto.NewClientMessageForEncode()
+	message.AddFrame(proto.NewFrame(content))
+
+	// when
+	pairs := DecodeEntryListIntegerLong(message.FrameIterator())
+
+	// then
+	assert.Equal(t, []proto.Pair{proto.NewPair(int32(1), int64(10)), proto.NewPair(int32(2), int64(20))}, pairs)
+}
+
 func TestListUUIDCodec_Encode(t *testing.T) {
 	// given
 	message := proto.NewClientMessageForEncode()
@@ -635,3 +675,50 @@ func TestStringCodec_Encode(t *testing.T) {
 	content := clientMessage.Frames[len(clientMessage.Frames)-1].Content
 	assert.Equal(t, value, string(content))
 }
+
+func TestEntryListIntegerIntegerCodec_EncodeDecode(t *testing.T) {
+	// given
+	pairs := []proto.Pair{proto.NewPair(int32(1), int32(-1)), proto.NewPair(int32(math.MaxInt32), int32(42))}
+	message := proto.NewClientMessageForEncode()
+
+	// when
+	EncodeEntryListIntegerInteger(message, pairs)
+	decoded := DecodeEntryListIntegerInteger(message.FrameIterator())
+
+	// then
+	assert.Equal(t, 2*(proto.IntSizeInBytes+proto.IntSizeInBytes), len(message.Frames[0].Content))
+	assert.Equal(t, pairs, decoded)
+}
+
+func TestPagingPredicateHolderCodec_EncodeDecode(t *testing.T) {
+	// given
+	anchors := NewAnchorDataListHolder(
+		[]int32{0, 1},
+		[]proto.Pair{
+			proto.NewPair(iserialization.NewData([]byte("key-1")), iserialization.NewData([]byte("value-1"))),
+			proto.NewPair(iserialization.NewData([]byte("key-2")), iserialization.NewData([]byte("value-2"))),
+		},
+	)
+	holder := NewPagingPredicateHolder(anchors, iserialization.NewData([]byte("predicate")), nil, 10, 2, 1, nil)
+	message := proto.NewClientMessageForEncode()
+
+	// when
+	EncodePagingPredicateHolder(message, holder)
+	decoded := DecodePagingPredicateHolder(message.FrameIterator())
+
+	// then
+	assert.Equal(t, holder, decoded)
+}
+
+func TestRaftGroupIdCodec_EncodeDecode(t *testing.T) {
+	// given
+	groupID := RaftGroupId{Name: "group", Seed: 1, ID: 2}
+	message := proto.NewClientMessageForEncode()
+
+	// when
+	EncodeRaftGroupId(message, groupID)
+	decoded := DecodeRaftGroupId(message.FrameIterator())
+
+	// then
+	assert.Equal(t, groupID, decoded)
+}
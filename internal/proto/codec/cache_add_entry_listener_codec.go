@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x130100
+	CacheAddEntryListenerCodecRequestMessageType = int32(1245440)
+	// hex: 0x130101
+	CacheAddEntryListenerCodecResponseMessageType = int32(1245441)
+
+	// hex: 0x130102
+	CacheAddEntryListenerCodecEventCacheMessageType = int32(1245442)
+
+	CacheAddEntryListenerCodecRequestLocalOnlyOffset  = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheAddEntryListenerCodecRequestInitialFrameSize = CacheAddEntryListenerCodecRequestLocalOnlyOffset + proto.BooleanSizeInBytes
+
+	CacheAddEntryListenerResponseResponseOffset       = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	CacheAddEntryListenerEventCacheEventTypeOffset    = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheAddEntryListenerEventCacheCompletionIdOffset = CacheAddEntryListenerEventCacheEventTypeOffset + proto.IntSizeInBytes
+)
+
+// Adds an entry listener to this cache.
+
+func EncodeCacheAddEntryListenerRequest(name string, localOnly bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheAddEntryListenerCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, CacheAddEntryListenerCodecRequestLocalOnlyOffset, localOnly)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheAddEntryListenerCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeCacheAddEntryListenerResponse(clientMessage *proto.ClientMessage) types.UUID {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeUUID(initialFrame.Content, CacheAddEntryListenerResponseResponseOffset)
+}
+
+func HandleCacheAddEntryListener(clientMessage *proto.ClientMessage, handleCacheEvent func(eventType int32, keys []CacheEventData, completionId int32)) {
+	messageType := clientMessage.Type()
+	frameIterator := clientMessage.FrameIterator()
+	if messageType == CacheAddEntryListenerCodecEventCacheMessageType {
+		initialFrame := frameIterator.Next()
+		eventType := FixSizedTypesCodec.DecodeInt(initialFrame.Content, CacheAddEntryListenerEventCacheEventTypeOffset)
+		completionId := FixSizedTypesCodec.DecodeInt(initialFrame.Content, CacheAddEntryListenerEventCacheCompletionIdOffset)
+		keys := DecodeListMultiFrameForCacheEventData(frameIterator)
+		handleCacheEvent(eventType, keys, completionId)
+		return
+	}
+}
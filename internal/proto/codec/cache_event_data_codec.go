@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	CacheEventDataCodecCacheEventTypeFieldOffset         = 0
+	CacheEventDataCodecOldValueAvailableFieldOffset      = CacheEventDataCodecCacheEventTypeFieldOffset + proto.IntSizeInBytes
+	CacheEventDataCodecOldValueAvailableInitialFrameSize = CacheEventDataCodecOldValueAvailableFieldOffset + proto.BooleanSizeInBytes
+)
+
+// CacheEventData contains the data of a single cache entry event.
+type CacheEventData struct {
+	Name              string
+	DataKey           *iserialization.Data
+	DataValue         *iserialization.Data
+	DataOldValue      *iserialization.Data
+	CacheEventType    int32
+	OldValueAvailable bool
+}
+
+func NewCacheEventData(name string, cacheEventType int32, dataKey, dataValue, dataOldValue *iserialization.Data, oldValueAvailable bool) CacheEventData {
+	return CacheEventData{
+		Name:              name,
+		CacheEventType:    cacheEventType,
+		DataKey:           dataKey,
+		DataValue:         dataValue,
+		DataOldValue:      dataOldValue,
+		OldValueAvailable: oldValueAvailable,
+	}
+}
+
+/*
+type cacheeventdataCodec struct {}
+
+var CacheEventDataCodec cacheeventdataCodec
+*/
+
+func EncodeCacheEventData(clientMessage *proto.ClientMessage, cacheEventData CacheEventData) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, CacheEventDataCodecOldValueAvailableInitialFrameSize))
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, CacheEventDataCodecCacheEventTypeFieldOffset, cacheEventData.CacheEventType)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, CacheEventDataCodecOldValueAvailableFieldOffset, cacheEventData.OldValueAvailable)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeString(clientMessage, cacheEventData.Name)
+	EncodeNullableData(clientMessage, cacheEventData.DataKey)
+	EncodeNullableData(clientMessage, cacheEventData.DataValue)
+	EncodeNullableData(clientMessage, cacheEventData.DataOldValue)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeCacheEventData(frameIterator *proto.ForwardFrameIterator) CacheEventData {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	cacheEventType := FixSizedTypesCodec.DecodeInt(initialFrame.Content, CacheEventDataCodecCacheEventTypeFieldOffset)
+	oldValueAvailable := FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, CacheEventDataCodecOldValueAvailableFieldOffset)
+
+	name := DecodeString(frameIterator)
+	dataKey := CodecUtil.DecodeNullableForData(frameIterator)
+	dataValue := CodecUtil.DecodeNullableForData(frameIterator)
+	dataOldValue := CodecUtil.DecodeNullableForData(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return NewCacheEventData(name, cacheEventType, dataKey, dataValue, dataOldValue, oldValueAvailable)
+}
+
+func DecodeListMultiFrameForCacheEventData(frameIterator *proto.ForwardFrameIterator) []CacheEventData {
+	result := []CacheEventData{}
+	// begin frame
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeCacheEventData(frameIterator))
+	}
+	// end frame
+	frameIterator.Next()
+	return result
+}
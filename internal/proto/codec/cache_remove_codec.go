@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x131600
+	CacheRemoveCodecRequestMessageType = int32(1250816)
+	// hex: 0x131601
+	CacheRemoveCodecResponseMessageType = int32(1250817)
+
+	CacheRemoveCodecRequestCompletionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheRemoveCodecRequestInitialFrameSize   = CacheRemoveCodecRequestCompletionIdOffset + proto.IntSizeInBytes
+
+	CacheRemoveResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Atomically removes the mapping for a key only if currently mapped to the given value.
+
+func EncodeCacheRemoveRequest(name string, key *iserialization.Data, currentValue *iserialization.Data, completionId int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheRemoveCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, CacheRemoveCodecRequestCompletionIdOffset, completionId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheRemoveCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeNullableData(clientMessage, currentValue)
+
+	return clientMessage
+}
+
+func DecodeCacheRemoveResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, CacheRemoveResponseResponseOffset)
+}
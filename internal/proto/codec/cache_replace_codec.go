@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x131700
+	CacheReplaceCodecRequestMessageType = int32(1251072)
+	// hex: 0x131701
+	CacheReplaceCodecResponseMessageType = int32(1251073)
+
+	CacheReplaceCodecRequestCompletionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CacheReplaceCodecRequestInitialFrameSize   = CacheReplaceCodecRequestCompletionIdOffset + proto.IntSizeInBytes
+)
+
+// Atomically replaces the assigned value of the given key by the specified value using a custom
+// javax.cache.expiry.ExpiryPolicy.
+
+func EncodeCacheReplaceRequest(name string, key *iserialization.Data, oldValue *iserialization.Data, newValue *iserialization.Data, expiryPolicy *iserialization.Data, completionId int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CacheReplaceCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, CacheReplaceCodecRequestCompletionIdOffset, completionId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CacheReplaceCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeNullableData(clientMessage, oldValue)
+	EncodeData(clientMessage, newValue)
+	EncodeNullableData(clientMessage, expiryPolicy)
+
+	return clientMessage
+}
+
+func DecodeCacheReplaceResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0x1C0100
+	CardinalityEstimatorAddCodecRequestMessageType = int32(1835264)
+	// hex: 0x1C0101
+	CardinalityEstimatorAddCodecResponseMessageType = int32(1835265)
+
+	CardinalityEstimatorAddCodecRequestHashOffset       = proto.PartitionIDOffset + proto.IntSizeInBytes
+	CardinalityEstimatorAddCodecRequestInitialFrameSize = CardinalityEstimatorAddCodecRequestHashOffset + proto.LongSizeInBytes
+)
+
+// Adds an item object in this estimator.
+
+func EncodeCardinalityEstimatorAddRequest(name string, hash int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, CardinalityEstimatorAddCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, CardinalityEstimatorAddCodecRequestHashOffset, hash)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(CardinalityEstimatorAddCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x160400
+	ContinuousQueryAddListenerCodecRequestMessageType = int32(1442816)
+	// hex: 0x160401
+	ContinuousQueryAddListenerCodecResponseMessageType = int32(1442817)
+
+	// hex: 0x160402
+	ContinuousQueryAddListenerCodecEventQueryCacheSingleMessageType = int32(1442818)
+
+	// hex: 0x160403
+	ContinuousQueryAddListenerCodecEventQueryCacheBatchMessageType = int32(1442819)
+
+	ContinuousQueryAddListenerCodecRequestLocalOnlyOffset  = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ContinuousQueryAddListenerCodecRequestInitialFrameSize = ContinuousQueryAddListenerCodecRequestLocalOnlyOffset + proto.BooleanSizeInBytes
+
+	ContinuousQueryAddListenerResponseResponseOffset                = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+	ContinuousQueryAddListenerEventQueryCacheBatchPartitionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+)
+
+// Adds a listener to the query cache.
+
+func EncodeContinuousQueryAddListenerRequest(listenerName string, localOnly bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ContinuousQueryAddListenerCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ContinuousQueryAddListenerCodecRequestLocalOnlyOffset, localOnly)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ContinuousQueryAddListenerCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, listenerName)
+
+	return clientMessage
+}
+
+func DecodeContinuousQueryAddListenerResponse(clientMessage *proto.ClientMessage) types.UUID {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeUUID(initialFrame.Content, ContinuousQueryAddListenerResponseResponseOffset)
+}
+
+func HandleContinuousQueryAddListener(clientMessage *proto.ClientMessage, handleQueryCacheSingleEvent func(data QueryCacheEventData), handleQueryCacheBatchEvent func(events []QueryCacheEventData, source string, partitionId int32)) {
+	messageType := clientMessage.Type()
+	frameIterator := clientMessage.FrameIterator()
+	if messageType == ContinuousQueryAddListenerCodecEventQueryCacheSingleMessageType {
+		// empty initial frame
+		frameIterator.Next()
+		data := DecodeQueryCacheEventData(frameIterator)
+		handleQueryCacheSingleEvent(data)
+		return
+	}
+	if messageType == ContinuousQueryAddListenerCodecEventQueryCacheBatchMessageType {
+		initialFrame := frameIterator.Next()
+		partitionId := FixSizedTypesCodec.DecodeInt(initialFrame.Content, ContinuousQueryAddListenerEventQueryCacheBatchPartitionIdOffset)
+		events := DecodeListMultiFrameForQueryCacheEventData(frameIterator)
+		source := DecodeString(frameIterator)
+		handleQueryCacheBatchEvent(events, source, partitionId)
+		return
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x180600
+	DurableExecutorRetrieveAndDisposeResultCodecRequestMessageType = int32(1574400)
+	// hex: 0x180601
+	DurableExecutorRetrieveAndDisposeResultCodecResponseMessageType = int32(1574401)
+
+	DurableExecutorRetrieveAndDisposeResultCodecRequestSequenceOffset   = proto.PartitionIDOffset + proto.IntSizeInBytes
+	DurableExecutorRetrieveAndDisposeResultCodecRequestInitialFrameSize = DurableExecutorRetrieveAndDisposeResultCodecRequestSequenceOffset + proto.IntSizeInBytes
+)
+
+// Retrieves and disposes the result of the execution with the given sequence
+
+func EncodeDurableExecutorRetrieveAndDisposeResultRequest(name string, sequence int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, DurableExecutorRetrieveAndDisposeResultCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, DurableExecutorRetrieveAndDisposeResultCodecRequestSequenceOffset, sequence)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(DurableExecutorRetrieveAndDisposeResultCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeDurableExecutorRetrieveAndDisposeResultResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
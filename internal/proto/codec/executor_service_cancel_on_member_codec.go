@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x080400
+	ExecutorServiceCancelOnMemberCodecRequestMessageType = int32(525312)
+	// hex: 0x080401
+	ExecutorServiceCancelOnMemberCodecResponseMessageType = int32(525313)
+
+	ExecutorServiceCancelOnMemberCodecRequestUuidOffset       = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ExecutorServiceCancelOnMemberCodecRequestMemberUUIDOffset = ExecutorServiceCancelOnMemberCodecRequestUuidOffset + proto.UuidSizeInBytes
+	ExecutorServiceCancelOnMemberCodecRequestInterruptOffset  = ExecutorServiceCancelOnMemberCodecRequestMemberUUIDOffset + proto.UuidSizeInBytes
+	ExecutorServiceCancelOnMemberCodecRequestInitialFrameSize = ExecutorServiceCancelOnMemberCodecRequestInterruptOffset + proto.BooleanSizeInBytes
+
+	ExecutorServiceCancelOnMemberResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Cancels the task running on the member with the given address.
+
+func EncodeExecutorServiceCancelOnMemberRequest(uuid types.UUID, memberUUID types.UUID, interrupt bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ExecutorServiceCancelOnMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ExecutorServiceCancelOnMemberCodecRequestUuidOffset, uuid)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ExecutorServiceCancelOnMemberCodecRequestMemberUUIDOffset, memberUUID)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ExecutorServiceCancelOnMemberCodecRequestInterruptOffset, interrupt)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ExecutorServiceCancelOnMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	return clientMessage
+}
+
+func DecodeExecutorServiceCancelOnMemberResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, ExecutorServiceCancelOnMemberResponseResponseOffset)
+}
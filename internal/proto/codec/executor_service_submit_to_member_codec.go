@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x080600
+	ExecutorServiceSubmitToMemberCodecRequestMessageType = int32(525824)
+	// hex: 0x080601
+	ExecutorServiceSubmitToMemberCodecResponseMessageType = int32(525825)
+
+	ExecutorServiceSubmitToMemberCodecRequestUuidOffset       = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ExecutorServiceSubmitToMemberCodecRequestMemberUUIDOffset = ExecutorServiceSubmitToMemberCodecRequestUuidOffset + proto.UuidSizeInBytes
+	ExecutorServiceSubmitToMemberCodecRequestInitialFrameSize = ExecutorServiceSubmitToMemberCodecRequestMemberUUIDOffset + proto.UuidSizeInBytes
+)
+
+// Submits the task to member for execution
+
+func EncodeExecutorServiceSubmitToMemberRequest(name string, uuid types.UUID, callable *iserialization.Data, memberUUID types.UUID) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ExecutorServiceSubmitToMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ExecutorServiceSubmitToMemberCodecRequestUuidOffset, uuid)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ExecutorServiceSubmitToMemberCodecRequestMemberUUIDOffset, memberUUID)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ExecutorServiceSubmitToMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, callable)
+
+	return clientMessage
+}
+
+func DecodeExecutorServiceSubmitToMemberResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
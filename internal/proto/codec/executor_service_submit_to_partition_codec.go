@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x080500
+	ExecutorServiceSubmitToPartitionCodecRequestMessageType = int32(525568)
+	// hex: 0x080501
+	ExecutorServiceSubmitToPartitionCodecResponseMessageType = int32(525569)
+
+	ExecutorServiceSubmitToPartitionCodecRequestUuidOffset       = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ExecutorServiceSubmitToPartitionCodecRequestInitialFrameSize = ExecutorServiceSubmitToPartitionCodecRequestUuidOffset + proto.UuidSizeInBytes
+)
+
+// Submits the task to partition for execution
+
+func EncodeExecutorServiceSubmitToPartitionRequest(name string, uuid types.UUID, callable *iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ExecutorServiceSubmitToPartitionCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ExecutorServiceSubmitToPartitionCodecRequestUuidOffset, uuid)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ExecutorServiceSubmitToPartitionCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, callable)
+
+	return clientMessage
+}
+
+func DecodeExecutorServiceSubmitToPartitionResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	PagingPredicateHolderCodecPageSizeFieldOffset             = 0
+	PagingPredicateHolderCodecPageFieldOffset                 = PagingPredicateHolderCodecPageSizeFieldOffset + proto.IntSizeInBytes
+	PagingPredicateHolderCodecIterationTypeIdFieldOffset      = PagingPredicateHolderCodecPageFieldOffset + proto.IntSizeInBytes
+	PagingPredicateHolderCodecIterationTypeIdInitialFrameSize = PagingPredicateHolderCodecIterationTypeIdFieldOffset + proto.ByteSizeInBytes
+)
+
+// PagingPredicateHolder is the wire representation of a paging predicate.
+type PagingPredicateHolder struct {
+	PredicateData        *iserialization.Data
+	ComparatorData       *iserialization.Data
+	PartitionKeyData     *iserialization.Data
+	AnchorDataListHolder AnchorDataListHolder
+	PageSize             int32
+	Page                 int32
+	IterationTypeID      byte
+}
+
+func NewPagingPredicateHolder(anchorDataListHolder AnchorDataListHolder, predicateData, comparatorData *iserialization.Data, pageSize, page int32, iterationTypeID byte, partitionKeyData *iserialization.Data) PagingPredicateHolder {
+	return PagingPredicateHolder{
+		AnchorDataListHolder: anchorDataListHolder,
+		PredicateData:        predicateData,
+		ComparatorData:       comparatorData,
+		PageSize:             pageSize,
+		Page:                 page,
+		IterationTypeID:      iterationTypeID,
+		PartitionKeyData:     partitionKeyData,
+	}
+}
+
+/*
+type pagingpredicateholderCodec struct {}
+
+var PagingPredicateHolderCodec pagingpredicateholderCodec
+*/
+
+func EncodePagingPredicateHolder(clientMessage *proto.ClientMessage, pagingPredicateHolder PagingPredicateHolder) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, PagingPredicateHolderCodecIterationTypeIdInitialFrameSize))
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, PagingPredicateHolderCodecPageSizeFieldOffset, pagingPredicateHolder.PageSize)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, PagingPredicateHolderCodecPageFieldOffset, pagingPredicateHolder.Page)
+	FixSizedTypesCodec.EncodeByte(initialFrame.Content, PagingPredicateHolderCodecIterationTypeIdFieldOffset, pagingPredicateHolder.IterationTypeID)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeAnchorDataListHolder(clientMessage, pagingPredicateHolder.AnchorDataListHolder)
+	EncodeNullableData(clientMessage, pagingPredicateHolder.PredicateData)
+	EncodeNullableData(clientMessage, pagingPredicateHolder.ComparatorData)
+	EncodeNullableData(clientMessage, pagingPredicateHolder.PartitionKeyData)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodePagingPredicateHolder(frameIterator *proto.ForwardFrameIterator) PagingPredicateHolder {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	pageSize := FixSizedTypesCodec.DecodeInt(initialFrame.Content, PagingPredicateHolderCodecPageSizeFieldOffset)
+	page := FixSizedTypesCodec.DecodeInt(initialFrame.Content, PagingPredicateHolderCodecPageFieldOffset)
+	iterationTypeId := FixSizedTypesCodec.DecodeByte(initialFrame.Content, PagingPredicateHolderCodecIterationTypeIdFieldOffset)
+
+	anchorDataListHolder := DecodeAnchorDataListHolder(frameIterator)
+	predicateData := DecodeNullableData(frameIterator)
+	comparatorData := DecodeNullableData(frameIterator)
+	partitionKeyData := DecodeNullableData(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return NewPagingPredicateHolder(anchorDataListHolder, predicateData, comparatorData, pageSize, page, iterationTypeId, partitionKeyData)
+}
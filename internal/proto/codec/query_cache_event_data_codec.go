@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	QueryCacheEventDataCodecSequenceFieldOffset         = 0
+	QueryCacheEventDataCodecEventTypeFieldOffset        = QueryCacheEventDataCodecSequenceFieldOffset + proto.LongSizeInBytes
+	QueryCacheEventDataCodecPartitionIdFieldOffset      = QueryCacheEventDataCodecEventTypeFieldOffset + proto.IntSizeInBytes
+	QueryCacheEventDataCodecPartitionIdInitialFrameSize = QueryCacheEventDataCodecPartitionIdFieldOffset + proto.IntSizeInBytes
+)
+
+// QueryCacheEventData contains the data of a single query cache event.
+// The sequence of the event is unique for the partition of the event.
+type QueryCacheEventData struct {
+	DataKey      *iserialization.Data
+	DataNewValue *iserialization.Data
+	Sequence     int64
+	EventType    int32
+	PartitionId  int32
+}
+
+func NewQueryCacheEventData(dataKey, dataNewValue *iserialization.Data, sequence int64, eventType int32, partitionId int32) QueryCacheEventData {
+	return QueryCacheEventData{
+		DataKey:      dataKey,
+		DataNewValue: dataNewValue,
+		Sequence:     sequence,
+		EventType:    eventType,
+		PartitionId:  partitionId,
+	}
+}
+
+/*
+type querycacheeventdataCodec struct {}
+
+var QueryCacheEventDataCodec querycacheeventdataCodec
+*/
+
+func EncodeQueryCacheEventData(clientMessage *proto.ClientMessage, queryCacheEventData QueryCacheEventData) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, QueryCacheEventDataCodecPartitionIdInitialFrameSize))
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, QueryCacheEventDataCodecSequenceFieldOffset, queryCacheEventData.Sequence)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, QueryCacheEventDataCodecEventTypeFieldOffset, queryCacheEventData.EventType)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, QueryCacheEventDataCodecPartitionIdFieldOffset, queryCacheEventData.PartitionId)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeNullableData(clientMessage, queryCacheEventData.DataKey)
+	EncodeNullableData(clientMessage, queryCacheEventData.DataNewValue)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeQueryCacheEventData(frameIterator *proto.ForwardFrameIterator) QueryCacheEventData {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	sequence := FixSizedTypesCodec.DecodeLong(initialFrame.Content, QueryCacheEventDataCodecSequenceFieldOffset)
+	eventType := FixSizedTypesCodec.DecodeInt(initialFrame.Content, QueryCacheEventDataCodecEventTypeFieldOffset)
+	partitionId := FixSizedTypesCodec.DecodeInt(initialFrame.Content, QueryCacheEventDataCodecPartitionIdFieldOffset)
+
+	dataKey := CodecUtil.DecodeNullableForData(frameIterator)
+	dataNewValue := CodecUtil.DecodeNullableForData(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return NewQueryCacheEventData(dataKey, dataNewValue, sequence, eventType, partitionId)
+}
+
+func EncodeListMultiFrameForQueryCacheEventData(message *proto.ClientMessage, values []QueryCacheEventData) {
+	message.AddFrame(proto.BeginFrame.Copy())
+	for _, value := range values {
+		EncodeQueryCacheEventData(message, value)
+	}
+	message.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeListMultiFrameForQueryCacheEventData(frameIterator *proto.ForwardFrameIterator) []QueryCacheEventData {
+	result := []QueryCacheEventData{}
+	// begin frame
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeQueryCacheEventData(frameIterator))
+	}
+	// end frame
+	frameIterator.Next()
+	return result
+}
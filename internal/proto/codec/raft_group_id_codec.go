@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	RaftGroupIdCodecSeedFieldOffset    = 0
+	RaftGroupIdCodecIdFieldOffset      = RaftGroupIdCodecSeedFieldOffset + proto.LongSizeInBytes
+	RaftGroupIdCodecIdInitialFrameSize = RaftGroupIdCodecIdFieldOffset + proto.LongSizeInBytes
+)
+
+// RaftGroupId identifies a CP group.
+type RaftGroupId struct {
+	Name string
+	Seed int64
+	ID   int64
+}
+
+/*
+type raftgroupidCodec struct {}
+
+var RaftGroupIdCodec raftgroupidCodec
+*/
+
+func EncodeRaftGroupId(clientMessage *proto.ClientMessage, raftGroupId RaftGroupId) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, RaftGroupIdCodecIdInitialFrameSize))
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, RaftGroupIdCodecSeedFieldOffset, raftGroupId.Seed)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, RaftGroupIdCodecIdFieldOffset, raftGroupId.ID)
+	clientMessage.AddFrame(initialFrame)
+
+	EncodeString(clientMessage, raftGroupId.Name)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeRaftGroupId(frameIterator *proto.ForwardFrameIterator) RaftGroupId {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	seed := FixSizedTypesCodec.DecodeLong(initialFrame.Content, RaftGroupIdCodecSeedFieldOffset)
+	id := FixSizedTypesCodec.DecodeLong(initialFrame.Content, RaftGroupIdCodecIdFieldOffset)
+
+	name := DecodeString(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return RaftGroupId{Name: name, Seed: seed, ID: id}
+}
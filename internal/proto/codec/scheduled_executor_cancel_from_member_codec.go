@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x1A0A00
+	ScheduledExecutorCancelFromMemberCodecRequestMessageType = int32(1706496)
+	// hex: 0x1A0A01
+	ScheduledExecutorCancelFromMemberCodecResponseMessageType = int32(1706497)
+
+	ScheduledExecutorCancelFromMemberCodecRequestMemberUuidOffset            = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorCancelFromMemberCodecRequestMayInterruptIfRunningOffset = ScheduledExecutorCancelFromMemberCodecRequestMemberUuidOffset + proto.UuidSizeInBytes
+	ScheduledExecutorCancelFromMemberCodecRequestInitialFrameSize            = ScheduledExecutorCancelFromMemberCodecRequestMayInterruptIfRunningOffset + proto.BooleanSizeInBytes
+
+	ScheduledExecutorCancelFromMemberResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Cancels further execution and scheduling of the task
+
+func EncodeScheduledExecutorCancelFromMemberRequest(schedulerName string, taskName string, memberUuid types.UUID, mayInterruptIfRunning bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorCancelFromMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ScheduledExecutorCancelFromMemberCodecRequestMemberUuidOffset, memberUuid)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ScheduledExecutorCancelFromMemberCodecRequestMayInterruptIfRunningOffset, mayInterruptIfRunning)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorCancelFromMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+
+	return clientMessage
+}
+
+func DecodeScheduledExecutorCancelFromMemberResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, ScheduledExecutorCancelFromMemberResponseResponseOffset)
+}
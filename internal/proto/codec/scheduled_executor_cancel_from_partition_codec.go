@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0x1A0900
+	ScheduledExecutorCancelFromPartitionCodecRequestMessageType = int32(1706240)
+	// hex: 0x1A0901
+	ScheduledExecutorCancelFromPartitionCodecResponseMessageType = int32(1706241)
+
+	ScheduledExecutorCancelFromPartitionCodecRequestMayInterruptIfRunningOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorCancelFromPartitionCodecRequestInitialFrameSize            = ScheduledExecutorCancelFromPartitionCodecRequestMayInterruptIfRunningOffset + proto.BooleanSizeInBytes
+
+	ScheduledExecutorCancelFromPartitionResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Cancels further execution and scheduling of the task
+
+func EncodeScheduledExecutorCancelFromPartitionRequest(schedulerName string, taskName string, mayInterruptIfRunning bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorCancelFromPartitionCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ScheduledExecutorCancelFromPartitionCodecRequestMayInterruptIfRunningOffset, mayInterruptIfRunning)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorCancelFromPartitionCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+
+	return clientMessage
+}
+
+func DecodeScheduledExecutorCancelFromPartitionResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, ScheduledExecutorCancelFromPartitionResponseResponseOffset)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	// hex: 0x1A1100
+	ScheduledExecutorDisposeFromPartitionCodecRequestMessageType = int32(1708288)
+	// hex: 0x1A1101
+	ScheduledExecutorDisposeFromPartitionCodecResponseMessageType = int32(1708289)
+
+	ScheduledExecutorDisposeFromPartitionCodecRequestInitialFrameSize = proto.PartitionIDOffset + proto.IntSizeInBytes
+)
+
+// Dispose the task from the scheduler
+
+func EncodeScheduledExecutorDisposeFromPartitionRequest(schedulerName string, taskName string) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorDisposeFromPartitionCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorDisposeFromPartitionCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+
+	return clientMessage
+}
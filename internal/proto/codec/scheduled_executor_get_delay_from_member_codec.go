@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x1A0800
+	ScheduledExecutorGetDelayFromMemberCodecRequestMessageType = int32(1705984)
+	// hex: 0x1A0801
+	ScheduledExecutorGetDelayFromMemberCodecResponseMessageType = int32(1705985)
+
+	ScheduledExecutorGetDelayFromMemberCodecRequestMemberUuidOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorGetDelayFromMemberCodecRequestInitialFrameSize = ScheduledExecutorGetDelayFromMemberCodecRequestMemberUuidOffset + proto.UuidSizeInBytes
+
+	ScheduledExecutorGetDelayFromMemberResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Returns the ScheduledFuture's delay in nanoseconds for the task in the scheduler.
+
+func EncodeScheduledExecutorGetDelayFromMemberRequest(schedulerName string, taskName string, memberUuid types.UUID) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorGetDelayFromMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ScheduledExecutorGetDelayFromMemberCodecRequestMemberUuidOffset, memberUuid)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorGetDelayFromMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+
+	return clientMessage
+}
+
+func DecodeScheduledExecutorGetDelayFromMemberResponse(clientMessage *proto.ClientMessage) int64 {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeLong(initialFrame.Content, ScheduledExecutorGetDelayFromMemberResponseResponseOffset)
+}
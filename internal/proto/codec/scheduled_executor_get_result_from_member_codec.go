@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x1A1000
+	ScheduledExecutorGetResultFromMemberCodecRequestMessageType = int32(1708032)
+	// hex: 0x1A1001
+	ScheduledExecutorGetResultFromMemberCodecResponseMessageType = int32(1708033)
+
+	ScheduledExecutorGetResultFromMemberCodecRequestMemberUuidOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorGetResultFromMemberCodecRequestInitialFrameSize = ScheduledExecutorGetResultFromMemberCodecRequestMemberUuidOffset + proto.UuidSizeInBytes
+)
+
+// Fetches the result of the task ({@link java.util.concurrent.Callable})
+// The call will blocking until the result is ready.
+
+func EncodeScheduledExecutorGetResultFromMemberRequest(schedulerName string, taskName string, memberUuid types.UUID) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorGetResultFromMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ScheduledExecutorGetResultFromMemberCodecRequestMemberUuidOffset, memberUuid)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorGetResultFromMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+
+	return clientMessage
+}
+
+func DecodeScheduledExecutorGetResultFromMemberResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x1A0C00
+	ScheduledExecutorIsCancelledFromMemberCodecRequestMessageType = int32(1707008)
+	// hex: 0x1A0C01
+	ScheduledExecutorIsCancelledFromMemberCodecResponseMessageType = int32(1707009)
+
+	ScheduledExecutorIsCancelledFromMemberCodecRequestMemberUuidOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorIsCancelledFromMemberCodecRequestInitialFrameSize = ScheduledExecutorIsCancelledFromMemberCodecRequestMemberUuidOffset + proto.UuidSizeInBytes
+
+	ScheduledExecutorIsCancelledFromMemberResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Checks whether a task as identified from the given handler is already cancelled.
+
+func EncodeScheduledExecutorIsCancelledFromMemberRequest(schedulerName string, taskName string, memberUuid types.UUID) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorIsCancelledFromMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ScheduledExecutorIsCancelledFromMemberCodecRequestMemberUuidOffset, memberUuid)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorIsCancelledFromMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+
+	return clientMessage
+}
+
+func DecodeScheduledExecutorIsCancelledFromMemberResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, ScheduledExecutorIsCancelledFromMemberResponseResponseOffset)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x1A0E00
+	ScheduledExecutorIsDoneFromMemberCodecRequestMessageType = int32(1707520)
+	// hex: 0x1A0E01
+	ScheduledExecutorIsDoneFromMemberCodecResponseMessageType = int32(1707521)
+
+	ScheduledExecutorIsDoneFromMemberCodecRequestMemberUuidOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorIsDoneFromMemberCodecRequestInitialFrameSize = ScheduledExecutorIsDoneFromMemberCodecRequestMemberUuidOffset + proto.UuidSizeInBytes
+
+	ScheduledExecutorIsDoneFromMemberResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Checks whether a task is done.
+
+func EncodeScheduledExecutorIsDoneFromMemberRequest(schedulerName string, taskName string, memberUuid types.UUID) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorIsDoneFromMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ScheduledExecutorIsDoneFromMemberCodecRequestMemberUuidOffset, memberUuid)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorIsDoneFromMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+
+	return clientMessage
+}
+
+func DecodeScheduledExecutorIsDoneFromMemberResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, ScheduledExecutorIsDoneFromMemberResponseResponseOffset)
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x1A0300
+	ScheduledExecutorSubmitToMemberCodecRequestMessageType = int32(1704704)
+	// hex: 0x1A0301
+	ScheduledExecutorSubmitToMemberCodecResponseMessageType = int32(1704705)
+
+	ScheduledExecutorSubmitToMemberCodecRequestMemberUuidOffset           = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorSubmitToMemberCodecRequestTaskTypeOffset             = ScheduledExecutorSubmitToMemberCodecRequestMemberUuidOffset + proto.UuidSizeInBytes
+	ScheduledExecutorSubmitToMemberCodecRequestInitialDelayInMillisOffset = ScheduledExecutorSubmitToMemberCodecRequestTaskTypeOffset + proto.ByteSizeInBytes
+	ScheduledExecutorSubmitToMemberCodecRequestPeriodInMillisOffset       = ScheduledExecutorSubmitToMemberCodecRequestInitialDelayInMillisOffset + proto.LongSizeInBytes
+	ScheduledExecutorSubmitToMemberCodecRequestAutoDisposableOffset       = ScheduledExecutorSubmitToMemberCodecRequestPeriodInMillisOffset + proto.LongSizeInBytes
+	ScheduledExecutorSubmitToMemberCodecRequestInitialFrameSize           = ScheduledExecutorSubmitToMemberCodecRequestAutoDisposableOffset + proto.BooleanSizeInBytes
+)
+
+// Submits the task to a member for execution, member is provided in the form of an address.
+
+func EncodeScheduledExecutorSubmitToMemberRequest(schedulerName string, memberUuid types.UUID, taskType byte, taskName string, task *iserialization.Data, initialDelayInMillis int64, periodInMillis int64, autoDisposable bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorSubmitToMemberCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, ScheduledExecutorSubmitToMemberCodecRequestMemberUuidOffset, memberUuid)
+	FixSizedTypesCodec.EncodeByte(initialFrame.Content, ScheduledExecutorSubmitToMemberCodecRequestTaskTypeOffset, taskType)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, ScheduledExecutorSubmitToMemberCodecRequestInitialDelayInMillisOffset, initialDelayInMillis)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, ScheduledExecutorSubmitToMemberCodecRequestPeriodInMillisOffset, periodInMillis)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ScheduledExecutorSubmitToMemberCodecRequestAutoDisposableOffset, autoDisposable)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorSubmitToMemberCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+	EncodeData(clientMessage, task)
+
+	return clientMessage
+}
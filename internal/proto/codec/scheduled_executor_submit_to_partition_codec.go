@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// hex: 0x1A0200
+	ScheduledExecutorSubmitToPartitionCodecRequestMessageType = int32(1704448)
+	// hex: 0x1A0201
+	ScheduledExecutorSubmitToPartitionCodecResponseMessageType = int32(1704449)
+
+	ScheduledExecutorSubmitToPartitionCodecRequestTaskTypeOffset             = proto.PartitionIDOffset + proto.IntSizeInBytes
+	ScheduledExecutorSubmitToPartitionCodecRequestInitialDelayInMillisOffset = ScheduledExecutorSubmitToPartitionCodecRequestTaskTypeOffset + proto.ByteSizeInBytes
+	ScheduledExecutorSubmitToPartitionCodecRequestPeriodInMillisOffset       = ScheduledExecutorSubmitToPartitionCodecRequestInitialDelayInMillisOffset + proto.LongSizeInBytes
+	ScheduledExecutorSubmitToPartitionCodecRequestAutoDisposableOffset       = ScheduledExecutorSubmitToPartitionCodecRequestPeriodInMillisOffset + proto.LongSizeInBytes
+	ScheduledExecutorSubmitToPartitionCodecRequestInitialFrameSize           = ScheduledExecutorSubmitToPartitionCodecRequestAutoDisposableOffset + proto.BooleanSizeInBytes
+)
+
+// Submits the task to partition for execution, partition is chosen based on multiple criteria of the given task.
+
+func EncodeScheduledExecutorSubmitToPartitionRequest(schedulerName string, taskType byte, taskName string, task *iserialization.Data, initialDelayInMillis int64, periodInMillis int64, autoDisposable bool) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, ScheduledExecutorSubmitToPartitionCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeByte(initialFrame.Content, ScheduledExecutorSubmitToPartitionCodecRequestTaskTypeOffset, taskType)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, ScheduledExecutorSubmitToPartitionCodecRequestInitialDelayInMillisOffset, initialDelayInMillis)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, ScheduledExecutorSubmitToPartitionCodecRequestPeriodInMillisOffset, periodInMillis)
+	FixSizedTypesCodec.EncodeBoolean(initialFrame.Content, ScheduledExecutorSubmitToPartitionCodecRequestAutoDisposableOffset, autoDisposable)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(ScheduledExecutorSubmitToPartitionCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, schedulerName)
+	EncodeString(clientMessage, taskName)
+	EncodeData(clientMessage, task)
+
+	return clientMessage
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0C0500
+	SemaphoreChangeCodecRequestMessageType = int32(787712)
+	// hex: 0x0C0501
+	SemaphoreChangeCodecResponseMessageType = int32(787713)
+
+	SemaphoreChangeCodecRequestSessionIdOffset     = proto.PartitionIDOffset + proto.IntSizeInBytes
+	SemaphoreChangeCodecRequestThreadIdOffset      = SemaphoreChangeCodecRequestSessionIdOffset + proto.LongSizeInBytes
+	SemaphoreChangeCodecRequestInvocationUidOffset = SemaphoreChangeCodecRequestThreadIdOffset + proto.LongSizeInBytes
+	SemaphoreChangeCodecRequestPermitsOffset       = SemaphoreChangeCodecRequestInvocationUidOffset + proto.UuidSizeInBytes
+	SemaphoreChangeCodecRequestInitialFrameSize    = SemaphoreChangeCodecRequestPermitsOffset + proto.IntSizeInBytes
+
+	SemaphoreChangeResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Increases or decreases the number of permits by the given value.
+
+func EncodeSemaphoreChangeRequest(groupId RaftGroupId, name string, sessionId int64, threadId int64, invocationUid types.UUID, permits int32) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, SemaphoreChangeCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, SemaphoreChangeCodecRequestSessionIdOffset, sessionId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, SemaphoreChangeCodecRequestThreadIdOffset, threadId)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, SemaphoreChangeCodecRequestInvocationUidOffset, invocationUid)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, SemaphoreChangeCodecRequestPermitsOffset, permits)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(SemaphoreChangeCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeRaftGroupId(clientMessage, groupId)
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeSemaphoreChangeResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, SemaphoreChangeResponseResponseOffset)
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	SqlColumnMetadataCodecTypeFieldOffset          = 0
+	SqlColumnMetadataCodecNullableFieldOffset      = SqlColumnMetadataCodecTypeFieldOffset + proto.IntSizeInBytes
+	SqlColumnMetadataCodecNullableInitialFrameSize = SqlColumnMetadataCodecNullableFieldOffset + proto.BooleanSizeInBytes
+)
+
+// SqlColumnMetadata describes a column of the rows returned by an SQL query.
+type SqlColumnMetadata struct {
+	Name     string
+	Type     int32
+	Nullable bool
+}
+
+/*
+type sqlcolumnmetadataCodec struct {}
+
+var SqlColumnMetadataCodec sqlcolumnmetadataCodec
+*/
+
+func DecodeSqlColumnMetadata(frameIterator *proto.ForwardFrameIterator) SqlColumnMetadata {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	columnType := FixSizedTypesCodec.DecodeInt(initialFrame.Content, SqlColumnMetadataCodecTypeFieldOffset)
+	// nullable was added in a later protocol version, the columns are nullable for the older members
+	nullable := true
+	if len(initialFrame.Content) >= SqlColumnMetadataCodecNullableInitialFrameSize {
+		nullable = FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, SqlColumnMetadataCodecNullableFieldOffset)
+	}
+
+	name := DecodeString(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return SqlColumnMetadata{Name: name, Type: columnType, Nullable: nullable}
+}
+
+func DecodeNullableListMultiFrameForSqlColumnMetadata(frameIterator *proto.ForwardFrameIterator) []SqlColumnMetadata {
+	if CodecUtil.NextFrameIsNullFrame(frameIterator) {
+		return nil
+	}
+	result := []SqlColumnMetadata{}
+	// begin frame
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		result = append(result, DecodeSqlColumnMetadata(frameIterator))
+	}
+	// end frame
+	frameIterator.Next()
+	return result
+}
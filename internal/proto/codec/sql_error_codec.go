@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	SqlErrorCodecCodeFieldOffset                     = 0
+	SqlErrorCodecOriginatingMemberIdFieldOffset      = SqlErrorCodecCodeFieldOffset + proto.IntSizeInBytes
+	SqlErrorCodecOriginatingMemberIdInitialFrameSize = SqlErrorCodecOriginatingMemberIdFieldOffset + proto.UuidSizeInBytes
+)
+
+// SqlError is the error returned by the member for a failed SQL query.
+type SqlError struct {
+	Message             string
+	Suggestion          string
+	OriginatingMemberId types.UUID
+	Code                int32
+}
+
+/*
+type sqlerrorCodec struct {}
+
+var SqlErrorCodec sqlerrorCodec
+*/
+
+func DecodeSqlError(frameIterator *proto.ForwardFrameIterator) SqlError {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	code := FixSizedTypesCodec.DecodeInt(initialFrame.Content, SqlErrorCodecCodeFieldOffset)
+	originatingMemberId := FixSizedTypesCodec.DecodeUUID(initialFrame.Content, SqlErrorCodecOriginatingMemberIdFieldOffset)
+
+	message := CodecUtil.DecodeNullableForString(frameIterator)
+	// suggestion was added in a later protocol version
+	var suggestion string
+	if !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		suggestion = CodecUtil.DecodeNullableForString(frameIterator)
+	}
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return SqlError{
+		Code:                code,
+		Message:             message,
+		OriginatingMemberId: originatingMemberId,
+		Suggestion:          suggestion,
+	}
+}
+
+func DecodeNullableForSqlError(frameIterator *proto.ForwardFrameIterator) *SqlError {
+	if CodecUtil.NextFrameIsNullFrame(frameIterator) {
+		return nil
+	}
+	sqlError := DecodeSqlError(frameIterator)
+	return &sqlError
+}
@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// SQL column type IDs, see sql.ColumnType
+const (
+	sqlColumnTypeVarchar               = 0
+	sqlColumnTypeBoolean               = 1
+	sqlColumnTypeTinyInt               = 2
+	sqlColumnTypeSmallInt              = 3
+	sqlColumnTypeInteger               = 4
+	sqlColumnTypeBigInt                = 5
+	sqlColumnTypeDecimal               = 6
+	sqlColumnTypeReal                  = 7
+	sqlColumnTypeDouble                = 8
+	sqlColumnTypeDate                  = 9
+	sqlColumnTypeTime                  = 10
+	sqlColumnTypeTimestamp             = 11
+	sqlColumnTypeTimestampWithTimeZone = 12
+	sqlColumnTypeObject                = 13
+	sqlColumnTypeNull                  = 14
+	sqlColumnTypeJSON                  = 15
+)
+
+// types of the lists of fixed size items which may contain nil items
+const (
+	listCNTypeNullOnly    = 1
+	listCNTypeNotNullOnly = 2
+	listCNTypeMixed       = 3
+	listCNItemsPerBitmask = 8
+	listCNHeaderSize      = proto.ByteSizeInBytes + proto.IntSizeInBytes
+)
+
+const (
+	localDateSizeInBytes      = proto.IntSizeInBytes + 2*proto.ByteSizeInBytes
+	localTimeSizeInBytes      = 3*proto.ByteSizeInBytes + proto.IntSizeInBytes
+	localDateTimeSizeInBytes  = localDateSizeInBytes + localTimeSizeInBytes
+	offsetDateTimeSizeInBytes = localDateTimeSizeInBytes + proto.IntSizeInBytes
+)
+
+// SqlPage is a page of rows returned by an SQL query.
+// The rows are stored column by column, the OBJECT column values are not deserialized.
+type SqlPage struct {
+	Columns [][]interface{}
+	Last    bool
+}
+
+// RowCount returns the number of rows in the page.
+func (p *SqlPage) RowCount() int {
+	if len(p.Columns) == 0 {
+		return 0
+	}
+	return len(p.Columns[0])
+}
+
+/*
+type sqlpageCodec struct {}
+
+var SqlPageCodec sqlpageCodec
+*/
+
+func DecodeSqlPage(frameIterator *proto.ForwardFrameIterator) *SqlPage {
+	// begin frame
+	frameIterator.Next()
+	last := frameIterator.Next().Content[0] == 1
+	columnTypeIDs := DecodeListInteger(frameIterator)
+	columns := make([][]interface{}, len(columnTypeIDs))
+	for i, columnTypeID := range columnTypeIDs {
+		columns[i] = decodeSqlPageColumn(frameIterator, columnTypeID)
+	}
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return &SqlPage{Columns: columns, Last: last}
+}
+
+func DecodeNullableForSqlPage(frameIterator *proto.ForwardFrameIterator) *SqlPage {
+	if CodecUtil.NextFrameIsNullFrame(frameIterator) {
+		return nil
+	}
+	return DecodeSqlPage(frameIterator)
+}
+
+func decodeSqlPageColumn(frameIterator *proto.ForwardFrameIterator, columnTypeID int32) []interface{} {
+	switch columnTypeID {
+	case sqlColumnTypeVarchar:
+		return decodeListMultiFrameContainsNullable(frameIterator, func(it *proto.ForwardFrameIterator) interface{} {
+			return DecodeString(it)
+		})
+	case sqlColumnTypeBoolean:
+		return decodeListCN(frameIterator, proto.BooleanSizeInBytes, func(b []byte, offset int32) interface{} {
+			return FixSizedTypesCodec.DecodeBoolean(b, offset)
+		})
+	case sqlColumnTypeTinyInt:
+		return decodeListCN(frameIterator, proto.ByteSizeInBytes, func(b []byte, offset int32) interface{} {
+			return int8(FixSizedTypesCodec.DecodeByte(b, offset))
+		})
+	case sqlColumnTypeSmallInt:
+		return decodeListCN(frameIterator, proto.ShortSizeInBytes, func(b []byte, offset int32) interface{} {
+			return int16(uint16(b[offset]) | uint16(b[offset+1])<<8)
+		})
+	case sqlColumnTypeInteger:
+		return decodeListCN(frameIterator, proto.IntSizeInBytes, func(b []byte, offset int32) interface{} {
+			return FixSizedTypesCodec.DecodeInt(b, offset)
+		})
+	case sqlColumnTypeBigInt:
+		return decodeListCN(frameIterator, proto.LongSizeInBytes, func(b []byte, offset int32) interface{} {
+			return FixSizedTypesCodec.DecodeLong(b, offset)
+		})
+	case sqlColumnTypeDecimal:
+		return decodeListMultiFrameContainsNullable(frameIterator, decodeDecimal)
+	case sqlColumnTypeReal:
+		return decodeListCN(frameIterator, proto.FloatSizeInBytes, func(b []byte, offset int32) interface{} {
+			return math.Float32frombits(uint32(FixSizedTypesCodec.DecodeInt(b, offset)))
+		})
+	case sqlColumnTypeDouble:
+		return decodeListCN(frameIterator, proto.DoubleSizeInBytes, func(b []byte, offset int32) interface{} {
+			return math.Float64frombits(uint64(FixSizedTypesCodec.DecodeLong(b, offset)))
+		})
+	case sqlColumnTypeDate:
+		return decodeListCN(frameIterator, localDateSizeInBytes, func(b []byte, offset int32) interface{} {
+			year, month, day := decodeLocalDate(b, offset)
+			return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+		})
+	case sqlColumnTypeTime:
+		return decodeListCN(frameIterator, localTimeSizeInBytes, func(b []byte, offset int32) interface{} {
+			hour, minute, second, nano := decodeLocalTime(b, offset)
+			return time.Date(0, 1, 1, hour, minute, second, nano, time.Local)
+		})
+	case sqlColumnTypeTimestamp:
+		return decodeListCN(frameIterator, localDateTimeSizeInBytes, func(b []byte, offset int32) interface{} {
+			return decodeLocalDateTime(b, offset, time.Local)
+		})
+	case sqlColumnTypeTimestampWithTimeZone:
+		return decodeListCN(frameIterator, offsetDateTimeSizeInBytes, func(b []byte, offset int32) interface{} {
+			offsetSeconds := FixSizedTypesCodec.DecodeInt(b, offset+localDateTimeSizeInBytes)
+			return decodeLocalDateTime(b, offset, time.FixedZone("", int(offsetSeconds)))
+		})
+	case sqlColumnTypeObject:
+		return decodeListMultiFrameContainsNullable(frameIterator, func(it *proto.ForwardFrameIterator) interface{} {
+			return DecodeData(it)
+		})
+	case sqlColumnTypeNull:
+		size := FixSizedTypesCodec.DecodeInt(frameIterator.Next().Content, 0)
+		return make([]interface{}, size)
+	case sqlColumnTypeJSON:
+		return decodeListMultiFrameContainsNullable(frameIterator, decodeHazelcastJsonValue)
+	default:
+		panic(fmt.Sprintf("unknown SQL column type ID: %d", columnTypeID))
+	}
+}
+
+// decodeListCN decodes a list of fixed size items which may contain nil items.
+// The mixed lists contain a bitmask before each group of items, the items which are nil are not included.
+func decodeListCN(frameIterator *proto.ForwardFrameIterator, itemSize int32, decode func(b []byte, offset int32) interface{}) []interface{} {
+	b := frameIterator.Next().Content
+	listType := b[0]
+	count := FixSizedTypesCodec.DecodeInt(b, proto.ByteSizeInBytes)
+	result := make([]interface{}, count)
+	switch listType {
+	case listCNTypeNullOnly:
+		// all items are nil
+	case listCNTypeNotNullOnly:
+		for i := int32(0); i < count; i++ {
+			result[i] = decode(b, listCNHeaderSize+i*itemSize)
+		}
+	case listCNTypeMixed:
+		position := int32(listCNHeaderSize)
+		var read int32
+		for read < count {
+			bitmask := b[position]
+			position++
+			for i := 0; i < listCNItemsPerBitmask && read < count; i++ {
+				if bitmask&(1<<i) != 0 {
+					result[read] = decode(b, position)
+					position += itemSize
+				}
+				read++
+			}
+		}
+	default:
+		panic(fmt.Sprintf("unknown list type: %d", listType))
+	}
+	return result
+}
+
+func decodeListMultiFrameContainsNullable(frameIterator *proto.ForwardFrameIterator, decode func(it *proto.ForwardFrameIterator) interface{}) []interface{} {
+	result := []interface{}{}
+	// begin frame
+	frameIterator.Next()
+	for !CodecUtil.NextFrameIsDataStructureEndFrame(frameIterator) {
+		if CodecUtil.NextFrameIsNullFrame(frameIterator) {
+			result = append(result, nil)
+		} else {
+			result = append(result, decode(frameIterator))
+		}
+	}
+	// end frame
+	frameIterator.Next()
+	return result
+}
+
+func decodeDecimal(frameIterator *proto.ForwardFrameIterator) interface{} {
+	b := frameIterator.Next().Content
+	size := FixSizedTypesCodec.DecodeInt(b, 0)
+	// the unscaled value is the big endian two's complement representation of the number
+	body := b[proto.IntSizeInBytes : proto.IntSizeInBytes+size]
+	unscaled := new(big.Int).SetBytes(body)
+	if len(body) > 0 && body[0]&0x80 != 0 {
+		unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(body)*8)))
+	}
+	scale := FixSizedTypesCodec.DecodeInt(b, proto.IntSizeInBytes+size)
+	return types.NewDecimal(unscaled, scale)
+}
+
+func decodeHazelcastJsonValue(frameIterator *proto.ForwardFrameIterator) interface{} {
+	// begin frame
+	frameIterator.Next()
+	value := DecodeString(frameIterator)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return serialization.JSON(value)
+}
+
+func decodeLocalDate(b []byte, offset int32) (int, time.Month, int) {
+	year := FixSizedTypesCodec.DecodeInt(b, offset)
+	month := FixSizedTypesCodec.DecodeByte(b, offset+proto.IntSizeInBytes)
+	day := FixSizedTypesCodec.DecodeByte(b, offset+proto.IntSizeInBytes+proto.ByteSizeInBytes)
+	return int(year), time.Month(month), int(day)
+}
+
+func decodeLocalTime(b []byte, offset int32) (int, int, int, int) {
+	hour := FixSizedTypesCodec.DecodeByte(b, offset)
+	minute := FixSizedTypesCodec.DecodeByte(b, offset+proto.ByteSizeInBytes)
+	second := FixSizedTypesCodec.DecodeByte(b, offset+2*proto.ByteSizeInBytes)
+	nano := FixSizedTypesCodec.DecodeInt(b, offset+3*proto.ByteSizeInBytes)
+	return int(hour), int(minute), int(second), int(nano)
+}
+
+func decodeLocalDateTime(b []byte, offset int32, loc *time.Location) time.Time {
+	year, month, day := decodeLocalDate(b, offset)
+	hour, minute, second, nano := decodeLocalTime(b, offset+localDateSizeInBytes)
+	return time.Date(year, month, day, hour, minute, second, nano, loc)
+}
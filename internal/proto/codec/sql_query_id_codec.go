@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+const (
+	SqlQueryIdCodecMemberIdHighFieldOffset    = 0
+	SqlQueryIdCodecMemberIdLowFieldOffset     = SqlQueryIdCodecMemberIdHighFieldOffset + proto.LongSizeInBytes
+	SqlQueryIdCodecLocalIdHighFieldOffset     = SqlQueryIdCodecMemberIdLowFieldOffset + proto.LongSizeInBytes
+	SqlQueryIdCodecLocalIdLowFieldOffset      = SqlQueryIdCodecLocalIdHighFieldOffset + proto.LongSizeInBytes
+	SqlQueryIdCodecLocalIdLowInitialFrameSize = SqlQueryIdCodecLocalIdLowFieldOffset + proto.LongSizeInBytes
+)
+
+// SqlQueryId identifies an SQL query.
+// The member ID is the UUID of the client which started the query, the local ID is unique for the client.
+type SqlQueryId struct {
+	MemberIDHigh int64
+	MemberIDLow  int64
+	LocalIDHigh  int64
+	LocalIDLow   int64
+}
+
+/*
+type sqlqueryidCodec struct {}
+
+var SqlQueryIdCodec sqlqueryidCodec
+*/
+
+func EncodeSqlQueryId(clientMessage *proto.ClientMessage, sqlQueryId SqlQueryId) {
+	clientMessage.AddFrame(proto.BeginFrame.Copy())
+	initialFrame := proto.NewFrame(make([]byte, SqlQueryIdCodecLocalIdLowInitialFrameSize))
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, SqlQueryIdCodecMemberIdHighFieldOffset, sqlQueryId.MemberIDHigh)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, SqlQueryIdCodecMemberIdLowFieldOffset, sqlQueryId.MemberIDLow)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, SqlQueryIdCodecLocalIdHighFieldOffset, sqlQueryId.LocalIDHigh)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, SqlQueryIdCodecLocalIdLowFieldOffset, sqlQueryId.LocalIDLow)
+	clientMessage.AddFrame(initialFrame)
+
+	clientMessage.AddFrame(proto.EndFrame.Copy())
+}
+
+func DecodeSqlQueryId(frameIterator *proto.ForwardFrameIterator) SqlQueryId {
+	// begin frame
+	frameIterator.Next()
+	initialFrame := frameIterator.Next()
+	memberIDHigh := FixSizedTypesCodec.DecodeLong(initialFrame.Content, SqlQueryIdCodecMemberIdHighFieldOffset)
+	memberIDLow := FixSizedTypesCodec.DecodeLong(initialFrame.Content, SqlQueryIdCodecMemberIdLowFieldOffset)
+	localIDHigh := FixSizedTypesCodec.DecodeLong(initialFrame.Content, SqlQueryIdCodecLocalIdHighFieldOffset)
+	localIDLow := FixSizedTypesCodec.DecodeLong(initialFrame.Content, SqlQueryIdCodecLocalIdLowFieldOffset)
+	CodecUtil.FastForwardToEndFrame(frameIterator)
+	return SqlQueryId{
+		MemberIDHigh: memberIDHigh,
+		MemberIDLow:  memberIDLow,
+		LocalIDHigh:  localIDHigh,
+		LocalIDLow:   localIDLow,
+	}
+}
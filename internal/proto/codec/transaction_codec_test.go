@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestTransactionCodecMessageTypes(t *testing.T) {
+	testCases := []struct {
+		name         string
+		request      *proto.ClientMessage
+		responseType int32
+		wantRequest  int32
+		wantResponse int32
+	}{
+		{
+			name:         "commit",
+			request:      EncodeTransactionCommitRequest(types.NewUUID(), 1),
+			responseType: TransactionCommitCodecResponseMessageType,
+			wantRequest:  0x150100,
+			wantResponse: 0x150101,
+		},
+		{
+			name:         "create",
+			request:      EncodeTransactionCreateRequest(1000, 1, 1, 1),
+			responseType: TransactionCreateCodecResponseMessageType,
+			wantRequest:  0x150200,
+			wantResponse: 0x150201,
+		},
+		{
+			name:         "rollback",
+			request:      EncodeTransactionRollbackRequest(types.NewUUID(), 1),
+			responseType: TransactionRollbackCodecResponseMessageType,
+			wantRequest:  0x150300,
+			wantResponse: 0x150301,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantRequest, tc.request.Type())
+			assert.Equal(t, tc.wantResponse, tc.responseType)
+		})
+	}
+}
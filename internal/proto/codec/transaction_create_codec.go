@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x150200
+	TransactionCreateCodecRequestMessageType = int32(1376768)
+	// hex: 0x150201
+	TransactionCreateCodecResponseMessageType = int32(1376769)
+
+	TransactionCreateCodecRequestTimeoutOffset         = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionCreateCodecRequestDurabilityOffset      = TransactionCreateCodecRequestTimeoutOffset + proto.LongSizeInBytes
+	TransactionCreateCodecRequestTransactionTypeOffset = TransactionCreateCodecRequestDurabilityOffset + proto.IntSizeInBytes
+	TransactionCreateCodecRequestThreadIdOffset        = TransactionCreateCodecRequestTransactionTypeOffset + proto.IntSizeInBytes
+	TransactionCreateCodecRequestInitialFrameSize      = TransactionCreateCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionCreateResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Creates a transaction with the given parameters.
+
+func EncodeTransactionCreateRequest(timeout int64, durability int32, transactionType int32, threadId int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionCreateCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionCreateCodecRequestTimeoutOffset, timeout)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, TransactionCreateCodecRequestDurabilityOffset, durability)
+	FixSizedTypesCodec.EncodeInt(initialFrame.Content, TransactionCreateCodecRequestTransactionTypeOffset, transactionType)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionCreateCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionCreateCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	return clientMessage
+}
+
+func DecodeTransactionCreateResponse(clientMessage *proto.ClientMessage) types.UUID {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeUUID(initialFrame.Content, TransactionCreateResponseResponseOffset)
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x150300
+	TransactionRollbackCodecRequestMessageType = int32(1377024)
+	// hex: 0x150301
+	TransactionRollbackCodecResponseMessageType = int32(1377025)
+
+	TransactionRollbackCodecRequestTransactionIdOffset = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionRollbackCodecRequestThreadIdOffset      = TransactionRollbackCodecRequestTransactionIdOffset + proto.UuidSizeInBytes
+	TransactionRollbackCodecRequestInitialFrameSize    = TransactionRollbackCodecRequestThreadIdOffset + proto.LongSizeInBytes
+)
+
+// Rollbacks the transaction with the given id.
+
+func EncodeTransactionRollbackRequest(transactionId types.UUID, threadId int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionRollbackCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionRollbackCodecRequestTransactionIdOffset, transactionId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionRollbackCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionRollbackCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	return clientMessage
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x110100
+	TransactionalListAddCodecRequestMessageType = int32(1114368)
+	// hex: 0x110101
+	TransactionalListAddCodecResponseMessageType = int32(1114369)
+
+	TransactionalListAddCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalListAddCodecRequestThreadIdOffset   = TransactionalListAddCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalListAddCodecRequestInitialFrameSize = TransactionalListAddCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionalListAddResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Add new item to transactional list.
+
+func EncodeTransactionalListAddRequest(name string, txnId types.UUID, threadId int64, item *iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalListAddCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalListAddCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalListAddCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalListAddCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, item)
+
+	return clientMessage
+}
+
+func DecodeTransactionalListAddResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, TransactionalListAddResponseResponseOffset)
+}
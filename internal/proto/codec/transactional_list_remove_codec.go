@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x110200
+	TransactionalListRemoveCodecRequestMessageType = int32(1114624)
+	// hex: 0x110201
+	TransactionalListRemoveCodecResponseMessageType = int32(1114625)
+
+	TransactionalListRemoveCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalListRemoveCodecRequestThreadIdOffset   = TransactionalListRemoveCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalListRemoveCodecRequestInitialFrameSize = TransactionalListRemoveCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionalListRemoveResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Remove item from transactional list.
+
+func EncodeTransactionalListRemoveRequest(name string, txnId types.UUID, threadId int64, item *iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalListRemoveCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalListRemoveCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalListRemoveCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalListRemoveCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, item)
+
+	return clientMessage
+}
+
+func DecodeTransactionalListRemoveResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, TransactionalListRemoveResponseResponseOffset)
+}
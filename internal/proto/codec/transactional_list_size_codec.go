@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x110300
+	TransactionalListSizeCodecRequestMessageType = int32(1114880)
+	// hex: 0x110301
+	TransactionalListSizeCodecResponseMessageType = int32(1114881)
+
+	TransactionalListSizeCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalListSizeCodecRequestThreadIdOffset   = TransactionalListSizeCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalListSizeCodecRequestInitialFrameSize = TransactionalListSizeCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionalListSizeResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Returns the size of the list.
+
+func EncodeTransactionalListSizeRequest(name string, txnId types.UUID, threadId int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalListSizeCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalListSizeCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalListSizeCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalListSizeCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeTransactionalListSizeResponse(clientMessage *proto.ClientMessage) int32 {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeInt(initialFrame.Content, TransactionalListSizeResponseResponseOffset)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0E0100
+	TransactionalMapContainsKeyCodecRequestMessageType = int32(917760)
+	// hex: 0x0E0101
+	TransactionalMapContainsKeyCodecResponseMessageType = int32(917761)
+
+	TransactionalMapContainsKeyCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalMapContainsKeyCodecRequestThreadIdOffset   = TransactionalMapContainsKeyCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalMapContainsKeyCodecRequestInitialFrameSize = TransactionalMapContainsKeyCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionalMapContainsKeyResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Returns true if this map contains an entry for the specified key.
+
+func EncodeTransactionalMapContainsKeyRequest(name string, txnId types.UUID, threadId int64, key *iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalMapContainsKeyCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalMapContainsKeyCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapContainsKeyCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalMapContainsKeyCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+
+	return clientMessage
+}
+
+func DecodeTransactionalMapContainsKeyResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, TransactionalMapContainsKeyResponseResponseOffset)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0E1200
+	TransactionalMapContainsValueCodecRequestMessageType = int32(922112)
+	// hex: 0x0E1201
+	TransactionalMapContainsValueCodecResponseMessageType = int32(922113)
+
+	TransactionalMapContainsValueCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalMapContainsValueCodecRequestThreadIdOffset   = TransactionalMapContainsValueCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalMapContainsValueCodecRequestInitialFrameSize = TransactionalMapContainsValueCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionalMapContainsValueResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Returns true if this map contains an entry for the specified value.
+
+func EncodeTransactionalMapContainsValueRequest(name string, txnId types.UUID, threadId int64, value *iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalMapContainsValueCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalMapContainsValueCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapContainsValueCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalMapContainsValueCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, value)
+
+	return clientMessage
+}
+
+func DecodeTransactionalMapContainsValueResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, TransactionalMapContainsValueResponseResponseOffset)
+}
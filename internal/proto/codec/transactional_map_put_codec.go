@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0E0600
+	TransactionalMapPutCodecRequestMessageType = int32(919040)
+	// hex: 0x0E0601
+	TransactionalMapPutCodecResponseMessageType = int32(919041)
+
+	TransactionalMapPutCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalMapPutCodecRequestThreadIdOffset   = TransactionalMapPutCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalMapPutCodecRequestTtlOffset        = TransactionalMapPutCodecRequestThreadIdOffset + proto.LongSizeInBytes
+	TransactionalMapPutCodecRequestInitialFrameSize = TransactionalMapPutCodecRequestTtlOffset + proto.LongSizeInBytes
+)
+
+// Associates the specified value with the specified key in this map.
+
+func EncodeTransactionalMapPutRequest(name string, txnId types.UUID, threadId int64, key *iserialization.Data, value *iserialization.Data, ttl int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalMapPutCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalMapPutCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapPutCodecRequestThreadIdOffset, threadId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapPutCodecRequestTtlOffset, ttl)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalMapPutCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeData(clientMessage, value)
+
+	return clientMessage
+}
+
+func DecodeTransactionalMapPutResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
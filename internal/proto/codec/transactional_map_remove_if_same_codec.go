@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0E0D00
+	TransactionalMapRemoveIfSameCodecRequestMessageType = int32(920832)
+	// hex: 0x0E0D01
+	TransactionalMapRemoveIfSameCodecResponseMessageType = int32(920833)
+
+	TransactionalMapRemoveIfSameCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalMapRemoveIfSameCodecRequestThreadIdOffset   = TransactionalMapRemoveIfSameCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalMapRemoveIfSameCodecRequestInitialFrameSize = TransactionalMapRemoveIfSameCodecRequestThreadIdOffset + proto.LongSizeInBytes
+
+	TransactionalMapRemoveIfSameResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Removes the entry for a key only if currently mapped to a given value.
+
+func EncodeTransactionalMapRemoveIfSameRequest(name string, txnId types.UUID, threadId int64, key *iserialization.Data, value *iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalMapRemoveIfSameCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalMapRemoveIfSameCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapRemoveIfSameCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalMapRemoveIfSameCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, key)
+	EncodeData(clientMessage, value)
+
+	return clientMessage
+}
+
+func DecodeTransactionalMapRemoveIfSameResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, TransactionalMapRemoveIfSameResponseResponseOffset)
+}
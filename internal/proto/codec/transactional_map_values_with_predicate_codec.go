@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x0E1100
+	TransactionalMapValuesWithPredicateCodecRequestMessageType = int32(921856)
+	// hex: 0x0E1101
+	TransactionalMapValuesWithPredicateCodecResponseMessageType = int32(921857)
+
+	TransactionalMapValuesWithPredicateCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalMapValuesWithPredicateCodecRequestThreadIdOffset   = TransactionalMapValuesWithPredicateCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalMapValuesWithPredicateCodecRequestInitialFrameSize = TransactionalMapValuesWithPredicateCodecRequestThreadIdOffset + proto.LongSizeInBytes
+)
+
+// Queries the map based on the specified predicate and returns the values of matching entries.
+
+func EncodeTransactionalMapValuesWithPredicateRequest(name string, txnId types.UUID, threadId int64, predicate *iserialization.Data) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalMapValuesWithPredicateCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalMapValuesWithPredicateCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalMapValuesWithPredicateCodecRequestThreadIdOffset, threadId)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalMapValuesWithPredicateCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, predicate)
+
+	return clientMessage
+}
+
+func DecodeTransactionalMapValuesWithPredicateResponse(clientMessage *proto.ClientMessage) []*iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return DecodeListMultiFrameForData(frameIterator)
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x120100
+	TransactionalQueueOfferCodecRequestMessageType = int32(1179904)
+	// hex: 0x120101
+	TransactionalQueueOfferCodecResponseMessageType = int32(1179905)
+
+	TransactionalQueueOfferCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalQueueOfferCodecRequestThreadIdOffset   = TransactionalQueueOfferCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalQueueOfferCodecRequestTimeoutOffset    = TransactionalQueueOfferCodecRequestThreadIdOffset + proto.LongSizeInBytes
+	TransactionalQueueOfferCodecRequestInitialFrameSize = TransactionalQueueOfferCodecRequestTimeoutOffset + proto.LongSizeInBytes
+
+	TransactionalQueueOfferResponseResponseOffset = proto.ResponseBackupAcksOffset + proto.ByteSizeInBytes
+)
+
+// Inserts the specified element into this queue, waiting up to the specified wait time if necessary for space to
+// become available.
+
+func EncodeTransactionalQueueOfferRequest(name string, txnId types.UUID, threadId int64, item *iserialization.Data, timeout int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalQueueOfferCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalQueueOfferCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalQueueOfferCodecRequestThreadIdOffset, threadId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalQueueOfferCodecRequestTimeoutOffset, timeout)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalQueueOfferCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+	EncodeData(clientMessage, item)
+
+	return clientMessage
+}
+
+func DecodeTransactionalQueueOfferResponse(clientMessage *proto.ClientMessage) bool {
+	frameIterator := clientMessage.FrameIterator()
+	initialFrame := frameIterator.Next()
+
+	return FixSizedTypesCodec.DecodeBoolean(initialFrame.Content, TransactionalQueueOfferResponseResponseOffset)
+}
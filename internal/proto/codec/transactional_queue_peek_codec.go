@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x120400
+	TransactionalQueuePeekCodecRequestMessageType = int32(1180672)
+	// hex: 0x120401
+	TransactionalQueuePeekCodecResponseMessageType = int32(1180673)
+
+	TransactionalQueuePeekCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalQueuePeekCodecRequestThreadIdOffset   = TransactionalQueuePeekCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalQueuePeekCodecRequestTimeoutOffset    = TransactionalQueuePeekCodecRequestThreadIdOffset + proto.LongSizeInBytes
+	TransactionalQueuePeekCodecRequestInitialFrameSize = TransactionalQueuePeekCodecRequestTimeoutOffset + proto.LongSizeInBytes
+)
+
+// Retrieves, but does not remove, the head of this queue, or returns null if this queue is empty.
+
+func EncodeTransactionalQueuePeekRequest(name string, txnId types.UUID, threadId int64, timeout int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalQueuePeekCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalQueuePeekCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalQueuePeekCodecRequestThreadIdOffset, threadId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalQueuePeekCodecRequestTimeoutOffset, timeout)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalQueuePeekCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeTransactionalQueuePeekResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package codec
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// hex: 0x120300
+	TransactionalQueuePollCodecRequestMessageType = int32(1180416)
+	// hex: 0x120301
+	TransactionalQueuePollCodecResponseMessageType = int32(1180417)
+
+	TransactionalQueuePollCodecRequestTxnIdOffset      = proto.PartitionIDOffset + proto.IntSizeInBytes
+	TransactionalQueuePollCodecRequestThreadIdOffset   = TransactionalQueuePollCodecRequestTxnIdOffset + proto.UuidSizeInBytes
+	TransactionalQueuePollCodecRequestTimeoutOffset    = TransactionalQueuePollCodecRequestThreadIdOffset + proto.LongSizeInBytes
+	TransactionalQueuePollCodecRequestInitialFrameSize = TransactionalQueuePollCodecRequestTimeoutOffset + proto.LongSizeInBytes
+)
+
+// Retrieves and removes the head of this queue, waiting up to the specified wait time if necessary for an element
+// to become available.
+
+func EncodeTransactionalQueuePollRequest(name string, txnId types.UUID, threadId int64, timeout int64) *proto.ClientMessage {
+	clientMessage := proto.NewClientMessageForEncode()
+	clientMessage.SetRetryable(false)
+
+	initialFrame := proto.NewFrameWith(make([]byte, TransactionalQueuePollCodecRequestInitialFrameSize), proto.UnfragmentedMessage)
+	FixSizedTypesCodec.EncodeUUID(initialFrame.Content, TransactionalQueuePollCodecRequestTxnIdOffset, txnId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalQueuePollCodecRequestThreadIdOffset, threadId)
+	FixSizedTypesCodec.EncodeLong(initialFrame.Content, TransactionalQueuePollCodecRequestTimeoutOffset, timeout)
+	clientMessage.AddFrame(initialFrame)
+	clientMessage.SetMessageType(TransactionalQueuePollCodecRequestMessageType)
+	clientMessage.SetPartitionId(-1)
+
+	EncodeString(clientMessage, name)
+
+	return clientMessage
+}
+
+func DecodeTransactionalQueuePollResponse(clientMessage *proto.ClientMessage) *iserialization.Data {
+	frameIterator := clientMessage.FrameIterator()
+	// empty initial frame
+	frameIterator.Next()
+
+	return CodecUtil.DecodeNullableForData(frameIterator)
+}
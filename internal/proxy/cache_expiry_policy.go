@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const (
+	cacheExpiryPolicyClassID = 27
+	// timeUnitMilliseconds is the ordinal of java.util.concurrent.TimeUnit.MILLISECONDS.
+	timeUnitMilliseconds = 2
+	// durationNotSet is written in place of a nil javax.cache.expiry.Duration.
+	durationNotSet = -1
+)
+
+// CacheExpiryPolicy is the serializable counterpart of com.hazelcast.cache.HazelcastExpiryPolicy.
+// Negative durations are sent as nil, which leaves the expiry of the entry unchanged.
+type CacheExpiryPolicy struct {
+	Creation time.Duration
+	Access   time.Duration
+	Update   time.Duration
+}
+
+func (p CacheExpiryPolicy) FactoryID() int32 {
+	return internal.CacheFactoryID
+}
+
+func (p CacheExpiryPolicy) ClassID() int32 {
+	return cacheExpiryPolicyClassID
+}
+
+func (p CacheExpiryPolicy) WriteData(output serialization.DataOutput) {
+	writeDuration(output, p.Creation)
+	writeDuration(output, p.Access)
+	writeDuration(output, p.Update)
+}
+
+func (p *CacheExpiryPolicy) ReadData(input serialization.DataInput) {
+	p.Creation = readDuration(input)
+	p.Access = readDuration(input)
+	p.Update = readDuration(input)
+}
+
+func writeDuration(output serialization.DataOutput, d time.Duration) {
+	if d < 0 {
+		output.WriteInt64(durationNotSet)
+		return
+	}
+	output.WriteInt64(d.Milliseconds())
+	output.WriteInt32(timeUnitMilliseconds)
+}
+
+func readDuration(input serialization.DataInput) time.Duration {
+	amount := input.ReadInt64()
+	if amount <= durationNotSet {
+		return durationNotSet
+	}
+	return javaTimeUnit(input.ReadInt32()) * time.Duration(amount)
+}
+
+// javaTimeUnit returns the duration of one unit of the java.util.concurrent.TimeUnit with the given ordinal.
+func javaTimeUnit(ordinal int32) time.Duration {
+	switch ordinal {
+	case 0:
+		return time.Nanosecond
+	case 1:
+		return time.Microsecond
+	case 2:
+		return time.Millisecond
+	case 3:
+		return time.Second
+	case 4:
+		return time.Minute
+	case 5:
+		return time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const (
+	addressClassID = 1
+	// typeDataSerializable is the serialization type ID of IdentifiedDataSerializable objects.
+	typeDataSerializable = -2
+)
+
+// Address is the serialized form of a member address.
+type Address struct {
+	Host string
+	Port int32
+	Type byte
+}
+
+func (a Address) FactoryID() int32 {
+	return internal.ClusterFactoryID
+}
+
+func (a Address) ClassID() int32 {
+	return addressClassID
+}
+
+func (a Address) WriteData(output serialization.DataOutput) {
+	output.WriteInt32(a.Port)
+	output.WriteByte(a.Type)
+	output.WriteString(a.Host)
+}
+
+func (a *Address) ReadData(input serialization.DataInput) {
+	a.Port = input.ReadInt32()
+	a.Type = input.ReadByte()
+	a.Host = input.ReadString()
+}
+
+// readAddress reads an address written as an object.
+// The address is decoded without looking up its factory, since the user may register a factory with the same ID.
+func readAddress(input serialization.DataInput) *Address {
+	pos := input.Position()
+	if input.ReadInt32() == typeDataSerializable && input.ReadBool() &&
+		input.ReadInt32() == internal.ClusterFactoryID && input.ReadInt32() == addressClassID {
+		addr := &Address{}
+		addr.ReadData(input)
+		return addr
+	}
+	input.SetPosition(pos)
+	addr, _ := input.ReadObject().(*Address)
+	return addr
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const eventJournalMapEventClassID = 129
+
+// EventJournalMapEvent is the counterpart of com.hazelcast.map.impl.journal.DeserializingEventJournalMapEvent.
+// The key and values are kept serialized, they are deserialized by the map proxy.
+type EventJournalMapEvent struct {
+	Key       []byte
+	NewValue  []byte
+	OldValue  []byte
+	EventType int32
+}
+
+func (e EventJournalMapEvent) FactoryID() int32 {
+	return internal.MapFactoryID
+}
+
+func (e EventJournalMapEvent) ClassID() int32 {
+	return eventJournalMapEventClassID
+}
+
+func (e EventJournalMapEvent) WriteData(output serialization.DataOutput) {
+	output.WriteInt32(e.EventType)
+	output.WriteByteArray(e.Key)
+	output.WriteByteArray(e.NewValue)
+	output.WriteByteArray(e.OldValue)
+}
+
+func (e *EventJournalMapEvent) ReadData(input serialization.DataInput) {
+	e.EventType = input.ReadInt32()
+	e.Key = input.ReadByteArray()
+	e.NewValue = input.ReadByteArray()
+	e.OldValue = input.ReadByteArray()
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const (
+	lazyMapEntryClassID          = 117
+	lockAwareLazyMapEntryClassID = 123
+)
+
+// MapFactory creates the map entries returned by the members, such as the results of MinBy and MaxBy aggregations,
+// and the event journal events.
+type MapFactory struct {
+}
+
+func (f MapFactory) Create(id int32) serialization.IdentifiedDataSerializable {
+	switch id {
+	case lazyMapEntryClassID:
+		return &LazyMapEntry{}
+	case lockAwareLazyMapEntryClassID:
+		return &LockAwareLazyMapEntry{}
+	case eventJournalMapEventClassID:
+		return &EventJournalMapEvent{}
+	}
+	return nil
+}
+
+func (f MapFactory) FactoryID() int32 {
+	return internal.MapFactoryID
+}
+
+type LazyMapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+func (e LazyMapEntry) FactoryID() int32 {
+	return internal.MapFactoryID
+}
+
+func (e LazyMapEntry) ClassID() int32 {
+	return lazyMapEntryClassID
+}
+
+func (e LazyMapEntry) WriteData(output serialization.DataOutput) {
+	output.WriteObject(e.Key)
+	output.WriteObject(e.Value)
+}
+
+func (e *LazyMapEntry) ReadData(input serialization.DataInput) {
+	e.Key = input.ReadObject()
+	e.Value = input.ReadObject()
+}
+
+// LockAwareLazyMapEntry is a LazyMapEntry which additionally carries the lock status of the entry.
+type LockAwareLazyMapEntry struct {
+	LazyMapEntry
+	Locked bool
+}
+
+func (e LockAwareLazyMapEntry) ClassID() int32 {
+	return lockAwareLazyMapEntryClassID
+}
+
+func (e LockAwareLazyMapEntry) WriteData(output serialization.DataOutput) {
+	e.LazyMapEntry.WriteData(output)
+	output.WriteBool(e.Locked)
+}
+
+func (e *LockAwareLazyMapEntry) ReadData(input serialization.DataInput) {
+	e.LazyMapEntry.ReadData(input)
+	e.Locked = input.ReadBool()
+}
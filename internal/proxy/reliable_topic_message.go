@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const reliableTopicMessageClassID = 2
+
+// TopicFactory creates the messages stored in the ringbuffer of a reliable topic.
+type TopicFactory struct {
+}
+
+func (f TopicFactory) Create(id int32) serialization.IdentifiedDataSerializable {
+	if id == reliableTopicMessageClassID {
+		return &ReliableTopicMessage{}
+	}
+	return nil
+}
+
+func (f TopicFactory) FactoryID() int32 {
+	return internal.TopicFactoryID
+}
+
+// ReliableTopicMessage is a message published to a reliable topic.
+// Payload is the serialized form of the message.
+type ReliableTopicMessage struct {
+	PublisherAddress *Address
+	Payload          []byte
+	PublishTime      int64
+}
+
+func (m ReliableTopicMessage) FactoryID() int32 {
+	return internal.TopicFactoryID
+}
+
+func (m ReliableTopicMessage) ClassID() int32 {
+	return reliableTopicMessageClassID
+}
+
+func (m ReliableTopicMessage) WriteData(output serialization.DataOutput) {
+	output.WriteInt64(m.PublishTime)
+	if m.PublisherAddress == nil {
+		output.WriteObject(nil)
+	} else {
+		output.WriteObject(m.PublisherAddress)
+	}
+	output.WriteByteArray(m.Payload)
+}
+
+func (m *ReliableTopicMessage) ReadData(input serialization.DataInput) {
+	m.PublishTime = input.ReadInt64()
+	m.PublisherAddress = readAddress(input)
+	m.Payload = input.ReadByteArray()
+}
@@ -66,3 +66,8 @@ func (d *Data) DataSize() int {
 func (d *Data) PartitionHash() int32 {
 	return murmur.Default3A(d.Payload, DataOffset, d.DataSize())
 }
+
+// Hash64 returns the 64-bit hash of the data, which is compatible with the hash computed by the Java member.
+func (d *Data) Hash64() int64 {
+	return murmur.Default3X64(d.Payload, DataOffset, d.DataSize())
+}
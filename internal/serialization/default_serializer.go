@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/gob"
 	"fmt"
+	"math/big"
 	"reflect"
 	"time"
 
@@ -391,6 +392,65 @@ func (JavaDateSerializer) Write(output serialization.DataOutput, i interface{})
 	output.WriteInt64(t.UnixNano() / 1000)
 }
 
+type JavaBigIntegerSerializer struct{}
+
+func (JavaBigIntegerSerializer) ID() int32 {
+	return TypeJavaBigInteger
+}
+
+func (JavaBigIntegerSerializer) Read(input serialization.DataInput) interface{} {
+	return javaBytesToBigInt(input.ReadByteArray())
+}
+
+func (JavaBigIntegerSerializer) Write(output serialization.DataOutput, i interface{}) {
+	output.WriteByteArray(bigIntToJavaBytes(i.(*big.Int)))
+}
+
+type JavaBigDecimalSerializer struct{}
+
+func (JavaBigDecimalSerializer) ID() int32 {
+	return TypeJavaBigDecimal
+}
+
+func (JavaBigDecimalSerializer) Read(input serialization.DataInput) interface{} {
+	unscaledValue := javaBytesToBigInt(input.ReadByteArray())
+	scale := input.ReadInt32()
+	return types.NewDecimal(unscaledValue, scale)
+}
+
+func (JavaBigDecimalSerializer) Write(output serialization.DataOutput, i interface{}) {
+	d := i.(types.Decimal)
+	output.WriteByteArray(bigIntToJavaBytes(d.UnscaledValue()))
+	output.WriteInt32(d.Scale())
+}
+
+// javaBytesToBigInt converts the big-endian two's complement representation used by java.math.BigInteger to *big.Int.
+func javaBytesToBigInt(b []byte) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		// negative number
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return v
+}
+
+// bigIntToJavaBytes converts *big.Int to the minimal big-endian two's complement representation used by java.math.BigInteger.
+func bigIntToJavaBytes(v *big.Int) []byte {
+	// x has the same bit length as the magnitude of v, excluding the sign bit
+	x := v
+	if v.Sign() < 0 {
+		x = new(big.Int).Neg(v)
+		x.Sub(x, big.NewInt(1))
+	}
+	size := x.BitLen()/8 + 1
+	u := v
+	if v.Sign() < 0 {
+		u = new(big.Int).Lsh(big.NewInt(1), uint(size*8))
+		u.Add(u, v)
+	}
+	return u.FillBytes(make([]byte, size))
+}
+
 type JavaClassSerializer struct{}
 
 func (JavaClassSerializer) ID() int32 {
@@ -424,6 +484,25 @@ func (JavaLinkedListSerializer) Write(output serialization.DataOutput, i interfa
 	// no-op
 }
 
+type JavaArraySerializer struct{}
+
+func (JavaArraySerializer) ID() int32 {
+	return TypeJavaArray
+}
+
+func (JavaArraySerializer) Read(input serialization.DataInput) interface{} {
+	count := int(input.ReadInt32())
+	res := make([]interface{}, count)
+	for i := 0; i < count; i++ {
+		res[i] = input.ReadObject()
+	}
+	return res
+}
+
+func (JavaArraySerializer) Write(output serialization.DataOutput, i interface{}) {
+	// no-op
+}
+
 type JavaArrayListSerializer struct{}
 
 func (JavaArrayListSerializer) ID() int32 {
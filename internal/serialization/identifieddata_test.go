@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
@@ -66,6 +67,16 @@ func (nullFactory) FactoryID() int32 {
 	return employeeFactoryID
 }
 
+type zeroFactory struct{}
+
+func (zeroFactory) Create(classID int32) serialization.IdentifiedDataSerializable {
+	return nil
+}
+
+func (zeroFactory) FactoryID() int32 {
+	return 0
+}
+
 func (e *employee) ReadData(input serialization.DataInput) {
 	e.age = input.ReadInt32()
 	e.name = input.ReadString()
@@ -112,3 +123,33 @@ func TestIdentifiedDataSerializableSerializer_NoInstanceCreated(t *testing.T) {
 		t.Fatalf("should fail as HazelcastSerializationError")
 	}
 }
+
+func TestIdentifiedDataSerializableSerializer_ReliableTopicMessageWithUserFactoryZero(t *testing.T) {
+	c := &serialization.Config{}
+	c.SetIdentifiedDataSerializableFactories(&zeroFactory{})
+	service, err := iserialization.NewService(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name string
+		addr *proxy.Address
+	}{
+		{name: "address", addr: &proxy.Address{Host: "127.0.0.1", Port: 5701}},
+		{name: "nil address"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &proxy.ReliableTopicMessage{PublisherAddress: tc.addr, Payload: []byte{1, 2, 3}, PublishTime: 1000}
+			data, err := service.ToData(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ret, err := service.ToObject(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, msg, ret)
+		})
+	}
+}
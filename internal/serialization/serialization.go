@@ -18,6 +18,7 @@ package serialization
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"time"
 
@@ -202,8 +203,14 @@ func (s *Service) lookupBuiltinDeserializer(typeID int32) pubserialization.Seria
 		return uuidSerializer
 	case TypeJavaDate:
 		return javaDateSerializer
+	case TypeJavaBigInteger:
+		return javaBigIntegerSerializer
+	case TypeJavaBigDecimal:
+		return javaBigDecimalSerializer
 	case TypeJSONSerialization:
 		return jsonSerializer
+	case TypeJavaArray:
+		return javaArraySerializer
 	case TypeJavaArrayList:
 		return javaArrayListSerializer
 	case TypeGobSerialization:
@@ -266,6 +273,8 @@ func (s *Service) lookUpGlobalSerializer() pubserialization.Serializer {
 func (s *Service) registerIdentifiedFactories() error {
 	fs := map[int32]pubserialization.IdentifiedDataSerializableFactory{
 		internal.AggregateFactoryID: &proxy.AggregateFactory{},
+		internal.MapFactoryID:       &proxy.MapFactory{},
+		internal.TopicFactoryID:     &proxy.TopicFactory{},
 	}
 	for _, f := range s.SerializationConfig.IdentifiedDataSerializableFactories() {
 		fid := f.FactoryID()
@@ -329,6 +338,10 @@ func (s *Service) lookupBuiltinSerializer(obj interface{}) pubserialization.Seri
 		return uuidSerializer
 	case time.Time:
 		return javaDateSerializer
+	case *big.Int:
+		return javaBigIntegerSerializer
+	case types.Decimal:
+		return javaBigDecimalSerializer
 	case pubserialization.JSON:
 		return jsonSerializer
 	}
@@ -369,5 +382,8 @@ var float64ArraySerializer = &Float64ArraySerializer{}
 var uuidSerializer = &UUIDSerializer{}
 var jsonSerializer = &JSONValueSerializer{}
 var javaDateSerializer = &JavaDateSerializer{}
+var javaBigIntegerSerializer = &JavaBigIntegerSerializer{}
+var javaBigDecimalSerializer = &JavaBigDecimalSerializer{}
+var javaArraySerializer = &JavaArraySerializer{}
 var javaArrayListSerializer = &JavaArrayListSerializer{}
 var gobSerializer = &GobSerializer{}
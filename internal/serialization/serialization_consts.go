@@ -42,6 +42,8 @@ const (
 	TypeJavaClass         = -24
 	TypeJavaDate          = -25
 	TypeJavaBigInteger    = -26
+	TypeJavaBigDecimal    = -27
+	TypeJavaArray         = -28
 	TypeJavaArrayList     = -29
 	TypeJavaLinkedList    = -30
 	TypeJSONSerialization = -130
@@ -19,6 +19,7 @@ package serialization_test
 import (
 	"encoding/binary"
 	"math"
+	"math/big"
 	"testing"
 	"time"
 	"unicode/utf8"
@@ -111,3 +112,44 @@ func (p PanicingGlobalSerializer) Read(input serialization.DataInput) interface{
 func (p PanicingGlobalSerializer) Write(output serialization.DataOutput, object interface{}) {
 	panic("panicing global serializer: write")
 }
+
+func TestSerializationImprovements_JavaBigInteger(t *testing.T) {
+	config := &serialization.Config{}
+	config.SetGlobalSerializer(&PanicingGlobalSerializer{})
+	ss := mustSerializationService(iserialization.NewService(config))
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	targets := []*big.Int{big.NewInt(0), big.NewInt(127), big.NewInt(128), big.NewInt(-128), big.NewInt(-129), huge}
+	for _, target := range targets {
+		data, err := ss.ToData(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := ss.ToObject(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 0, target.Cmp(value.(*big.Int)), "target: %s, value: %s", target, value)
+	}
+	// java.math.BigInteger.valueOf(-129).toByteArray()
+	data, err := ss.ToData(big.NewInt(-129))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{0, 0, 0, 2, 0xff, 0x7f}, data.ToByteArray()[8:])
+}
+
+func TestSerializationImprovements_JavaBigDecimal(t *testing.T) {
+	config := &serialization.Config{}
+	config.SetGlobalSerializer(&PanicingGlobalSerializer{})
+	ss := mustSerializationService(iserialization.NewService(config))
+	target := types.NewDecimal(big.NewInt(-12345), 3)
+	data, err := ss.ToData(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := ss.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "-12.345", value.(types.Decimal).String())
+}
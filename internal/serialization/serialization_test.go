@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
@@ -365,3 +366,60 @@ func mustData(value interface{}, err error) *iserialization.Data {
 	}
 	return value.(*iserialization.Data)
 }
+
+func TestJavaArrayDeserialization(t *testing.T) {
+	service, err := iserialization.NewService(&serialization.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// partition hash, type ID and the item count
+	payload := []byte{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xe4, 0, 0, 0, 2}
+	// int32: 42
+	payload = append(payload, 0xff, 0xff, 0xff, 0xf9, 0, 0, 0, 42)
+	// string: "foo"
+	payload = append(payload, 0xff, 0xff, 0xff, 0xf5, 0, 0, 0, 3, 'f', 'o', 'o')
+	value, err := service.ToObject(iserialization.NewData(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []interface{}{int32(42), "foo"}, value)
+}
+
+func TestLazyMapEntryDeserialization(t *testing.T) {
+	service, err := iserialization.NewService(&serialization.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// partition hash, type ID, identified flag, factory ID and class ID
+	payload := []byte{0, 0, 0, 0, 0xff, 0xff, 0xff, 0xfe, 1, 0xff, 0xff, 0xff, 0xf6, 0, 0, 0, 117}
+	// key, string: "k"
+	payload = append(payload, 0xff, 0xff, 0xff, 0xf5, 0, 0, 0, 1, 'k')
+	// value, int32: 42
+	payload = append(payload, 0xff, 0xff, 0xff, 0xf9, 0, 0, 0, 42)
+	value, err := service.ToObject(iserialization.NewData(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &proxy.LazyMapEntry{Key: "k", Value: int32(42)}, value)
+}
+
+func TestReliableTopicMessageSerialization(t *testing.T) {
+	service, err := iserialization.NewService(&serialization.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := &proxy.ReliableTopicMessage{
+		PublishTime:      1234,
+		PublisherAddress: &proxy.Address{Host: "127.0.0.1", Port: 5701},
+		Payload:          []byte{1, 2, 3},
+	}
+	data, err := service.ToData(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, target, value)
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"context"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/sql"
+)
+
+type result struct {
+	service          *Service
+	member           *pubcluster.MemberInfo
+	metadata         *rowMetadata
+	page             *codec.SqlPage
+	queryID          codec.SqlQueryId
+	updateCount      int64
+	cursorBufferSize int32
+	index            int
+	closed           bool
+}
+
+func newResult(s *Service, member *pubcluster.MemberInfo, queryID codec.SqlQueryId, cursorBufferSize int32, metadata []codec.SqlColumnMetadata, page *codec.SqlPage, updateCount int64) *result {
+	r := &result{
+		service:          s,
+		member:           member,
+		queryID:          queryID,
+		cursorBufferSize: cursorBufferSize,
+		updateCount:      updateCount,
+		page:             page,
+	}
+	if metadata != nil {
+		r.metadata = newRowMetadata(metadata)
+	}
+	return r
+}
+
+func (r *result) RowMetadata() (sql.RowMetadata, error) {
+	if r.metadata == nil {
+		return nil, ihzerrors.NewClientError("result contains an update count, not rows", nil, hzerrors.ErrIllegalState)
+	}
+	return r.metadata, nil
+}
+
+func (r *result) IsRowSet() bool {
+	return r.metadata != nil
+}
+
+func (r *result) UpdateCount() int64 {
+	if r.metadata != nil {
+		return -1
+	}
+	return r.updateCount
+}
+
+func (r *result) HasNext(ctx context.Context) (bool, error) {
+	if r.metadata == nil {
+		return false, nil
+	}
+	for {
+		if r.closed {
+			return false, ihzerrors.NewClientError("result is closed", nil, hzerrors.ErrIllegalState)
+		}
+		if r.page != nil && r.index < r.page.RowCount() {
+			return true, nil
+		}
+		if r.page == nil || r.page.Last {
+			return false, nil
+		}
+		page, err := r.service.fetch(ctx, r.member, r.queryID, r.cursorBufferSize)
+		if err != nil {
+			// the query is already closed on the member if it failed
+			r.closed = true
+			return false, err
+		}
+		r.page = page
+		r.index = 0
+	}
+}
+
+func (r *result) Next(ctx context.Context) (sql.Row, error) {
+	ok, err := r.HasNext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, hzerrors.ErrNoSuchElement
+	}
+	values := make([]interface{}, len(r.page.Columns))
+	for i, column := range r.page.Columns {
+		if values[i], err = r.service.toObject(column[r.index]); err != nil {
+			return nil, err
+		}
+	}
+	r.index++
+	return newRow(r.metadata, values), nil
+}
+
+func (r *result) Close(ctx context.Context) error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	if r.metadata == nil || r.page == nil || r.page.Last {
+		// the member releases the query once the last page is sent
+		return nil
+	}
+	return r.service.close(ctx, r.member, r.queryID)
+}
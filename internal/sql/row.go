@@ -0,0 +1,237 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/sql"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+type rowMetadata struct {
+	nameToIndex map[string]int
+	columns     []sql.ColumnMetadata
+}
+
+func newRowMetadata(columns []codec.SqlColumnMetadata) *rowMetadata {
+	md := &rowMetadata{
+		nameToIndex: make(map[string]int, len(columns)),
+		columns:     make([]sql.ColumnMetadata, len(columns)),
+	}
+	for i, c := range columns {
+		md.columns[i] = sql.ColumnMetadata{
+			Name:     c.Name,
+			Type:     sql.ColumnType(c.Type),
+			Nullable: c.Nullable,
+		}
+		md.nameToIndex[c.Name] = i
+	}
+	return md
+}
+
+func (m *rowMetadata) ColumnCount() int {
+	return len(m.columns)
+}
+
+func (m *rowMetadata) Column(index int) (sql.ColumnMetadata, error) {
+	if err := m.checkIndex(index); err != nil {
+		return sql.ColumnMetadata{}, err
+	}
+	return m.columns[index], nil
+}
+
+func (m *rowMetadata) Columns() []sql.ColumnMetadata {
+	columns := make([]sql.ColumnMetadata, len(m.columns))
+	copy(columns, m.columns)
+	return columns
+}
+
+func (m *rowMetadata) FindColumn(name string) (int, error) {
+	if i, ok := m.nameToIndex[name]; ok {
+		return i, nil
+	}
+	return -1, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("column %s does not exist", name), nil)
+}
+
+func (m *rowMetadata) checkIndex(index int) error {
+	if index < 0 || index >= len(m.columns) {
+		msg := fmt.Sprintf("column index %d is out of bounds, column count: %d", index, len(m.columns))
+		return ihzerrors.NewClientError(msg, nil, hzerrors.ErrIndexOutOfBounds)
+	}
+	return nil
+}
+
+type row struct {
+	metadata *rowMetadata
+	values   []interface{}
+}
+
+func newRow(metadata *rowMetadata, values []interface{}) *row {
+	return &row{metadata: metadata, values: values}
+}
+
+func (r *row) Metadata() sql.RowMetadata {
+	return r.metadata
+}
+
+func (r *row) Get(index int) (interface{}, error) {
+	if err := r.metadata.checkIndex(index); err != nil {
+		return nil, err
+	}
+	return r.values[index], nil
+}
+
+func (r *row) GetByColumnName(name string) (interface{}, error) {
+	index, err := r.metadata.FindColumn(name)
+	if err != nil {
+		return nil, err
+	}
+	return r.values[index], nil
+}
+
+func (r *row) GetString(index int) (string, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return "", err
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return "", r.classCastError(index, v, "string")
+}
+
+func (r *row) GetBool(index int) (bool, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return false, err
+	}
+	if b, ok := v.(bool); ok {
+		return b, nil
+	}
+	return false, r.classCastError(index, v, "bool")
+}
+
+func (r *row) GetInt8(index int) (int8, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return 0, err
+	}
+	if n, ok := v.(int8); ok {
+		return n, nil
+	}
+	return 0, r.classCastError(index, v, "int8")
+}
+
+func (r *row) GetInt16(index int) (int16, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return 0, err
+	}
+	if n, ok := v.(int16); ok {
+		return n, nil
+	}
+	return 0, r.classCastError(index, v, "int16")
+}
+
+func (r *row) GetInt32(index int) (int32, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return 0, err
+	}
+	if n, ok := v.(int32); ok {
+		return n, nil
+	}
+	return 0, r.classCastError(index, v, "int32")
+}
+
+func (r *row) GetInt64(index int) (int64, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return 0, err
+	}
+	if n, ok := v.(int64); ok {
+		return n, nil
+	}
+	return 0, r.classCastError(index, v, "int64")
+}
+
+func (r *row) GetFloat32(index int) (float32, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return 0, err
+	}
+	if f, ok := v.(float32); ok {
+		return f, nil
+	}
+	return 0, r.classCastError(index, v, "float32")
+}
+
+func (r *row) GetFloat64(index int) (float64, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return 0, err
+	}
+	if f, ok := v.(float64); ok {
+		return f, nil
+	}
+	return 0, r.classCastError(index, v, "float64")
+}
+
+func (r *row) GetDecimal(index int) (types.Decimal, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return types.Decimal{}, err
+	}
+	if d, ok := v.(types.Decimal); ok {
+		return d, nil
+	}
+	return types.Decimal{}, r.classCastError(index, v, "types.Decimal")
+}
+
+func (r *row) GetTime(index int) (time.Time, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if t, ok := v.(time.Time); ok {
+		return t, nil
+	}
+	return time.Time{}, r.classCastError(index, v, "time.Time")
+}
+
+func (r *row) GetJSON(index int) (serialization.JSON, error) {
+	v, err := r.Get(index)
+	if err != nil {
+		return nil, err
+	}
+	if j, ok := v.(serialization.JSON); ok {
+		return j, nil
+	}
+	return nil, r.classCastError(index, v, "serialization.JSON")
+}
+
+func (r *row) classCastError(index int, value interface{}, target string) error {
+	column := r.metadata.columns[index]
+	msg := fmt.Sprintf("cannot convert value %v of column %s (%s) to %s", value, column.Name, column.Type, target)
+	return ihzerrors.NewClientError(msg, nil, hzerrors.ErrClassCast)
+}
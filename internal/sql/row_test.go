@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/sql"
+)
+
+func TestRowMetadata_FindColumn(t *testing.T) {
+	md := newRowMetadata([]codec.SqlColumnMetadata{
+		{Name: "__key", Type: int32(sql.ColumnTypeInteger)},
+		{Name: "this", Type: int32(sql.ColumnTypeVarchar), Nullable: true},
+	})
+	assert.Equal(t, 2, md.ColumnCount())
+	index, err := md.FindColumn("this")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, index)
+	if _, err := md.FindColumn("foo"); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+		t.Fatalf("expected illegal argument error, got: %v", err)
+	}
+	column, err := md.Column(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, sql.ColumnMetadata{Name: "this", Type: sql.ColumnTypeVarchar, Nullable: true}, column)
+	if _, err := md.Column(2); !errors.Is(err, hzerrors.ErrIndexOutOfBounds) {
+		t.Fatalf("expected index out of bounds error, got: %v", err)
+	}
+}
+
+func TestRow_Getters(t *testing.T) {
+	md := newRowMetadata([]codec.SqlColumnMetadata{
+		{Name: "a", Type: int32(sql.ColumnTypeInteger)},
+		{Name: "b", Type: int32(sql.ColumnTypeVarchar), Nullable: true},
+		{Name: "c", Type: int32(sql.ColumnTypeTimestamp)},
+	})
+	ts := time.Date(2021, 10, 5, 12, 30, 0, 0, time.Local)
+	r := newRow(md, []interface{}{int32(42), nil, ts})
+	n, err := r.GetInt32(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, int32(42), n)
+	v, err := r.GetByColumnName("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, v)
+	tm, err := r.GetTime(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ts, tm)
+	if _, err := r.GetInt64(0); !errors.Is(err, hzerrors.ErrClassCast) {
+		t.Fatalf("expected class cast error, got: %v", err)
+	}
+	if _, err := r.GetString(1); !errors.Is(err, hzerrors.ErrClassCast) {
+		t.Fatalf("expected class cast error, got: %v", err)
+	}
+	if _, err := r.Get(3); !errors.Is(err, hzerrors.ErrIndexOutOfBounds) {
+		t.Fatalf("expected index out of bounds error, got: %v", err)
+	}
+}
+
+func TestTimeoutMillis(t *testing.T) {
+	assert.Equal(t, int64(timeoutNotSet), timeoutMillis(0))
+	assert.Equal(t, int64(timeoutDisabled), timeoutMillis(-1))
+	assert.Equal(t, int64(1500), timeoutMillis(1500*time.Millisecond))
+}
@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/sql"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// timeoutNotSet makes the member use the timeout in its configuration.
+	timeoutNotSet = -1
+	// timeoutDisabled disables the timeout.
+	timeoutDisabled = 0
+)
+
+type ServiceCreationBundle struct {
+	SerializationService *iserialization.Service
+	ClusterService       *cluster.Service
+	InvocationService    *invocation.Service
+	InvocationFactory    *cluster.ConnectionInvocationFactory
+	ClientUUID           types.UUID
+}
+
+func (b ServiceCreationBundle) Check() {
+	if b.SerializationService == nil {
+		panic("SerializationService is nil")
+	}
+	if b.ClusterService == nil {
+		panic("ClusterService is nil")
+	}
+	if b.InvocationService == nil {
+		panic("InvocationService is nil")
+	}
+	if b.InvocationFactory == nil {
+		panic("InvocationFactory is nil")
+	}
+}
+
+// Service runs SQL queries on a data member of the cluster.
+// The pages of the rows of a query are fetched from the member which runs the query.
+type Service struct {
+	ss                *iserialization.Service
+	clusterService    *cluster.Service
+	invocationService *invocation.Service
+	invocationFactory *cluster.ConnectionInvocationFactory
+	clientUUID        types.UUID
+}
+
+func NewService(bundle ServiceCreationBundle) *Service {
+	bundle.Check()
+	return &Service{
+		ss:                bundle.SerializationService,
+		clusterService:    bundle.ClusterService,
+		invocationService: bundle.InvocationService,
+		invocationFactory: bundle.InvocationFactory,
+		clientUUID:        bundle.ClientUUID,
+	}
+}
+
+// Execute runs the given SQL string with the given parameters.
+func (s *Service) Execute(ctx context.Context, statement string, params ...interface{}) (sql.Result, error) {
+	return s.ExecuteStatement(ctx, sql.NewStatement(statement, params...))
+}
+
+// ExecuteStatement runs the given statement.
+func (s *Service) ExecuteStatement(ctx context.Context, stmt sql.Statement) (sql.Result, error) {
+	if stmt.SQL == "" {
+		return nil, ihzerrors.NewIllegalArgumentError("SQL string cannot be blank", nil)
+	}
+	cbs := stmt.CursorBufferSize
+	if cbs == 0 {
+		cbs = sql.DefaultCursorBufferSize
+	} else if cbs < 0 {
+		return nil, ihzerrors.NewIllegalArgumentError("cursor buffer size must be positive", nil)
+	}
+	params := make([]*iserialization.Data, len(stmt.Parameters))
+	for i, param := range stmt.Parameters {
+		data, err := s.ss.ToData(param)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = data
+	}
+	member := s.randomDataMember()
+	if member == nil {
+		return nil, ihzerrors.NewClientError("no data member to run the SQL query", nil, hzerrors.ErrNoDataMember)
+	}
+	queryID := s.newQueryID()
+	req := codec.EncodeSqlExecuteRequest(stmt.SQL, params, timeoutMillis(stmt.Timeout), cbs, stmt.Schema, byte(stmt.ExpectedResultType), queryID, false)
+	resp, err := s.invokeOnMember(ctx, req, member)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		metadata    []codec.SqlColumnMetadata
+		page        *codec.SqlPage
+		updateCount int64
+		sqlErr      *codec.SqlError
+	)
+	err = decode(func() {
+		metadata, page, updateCount, sqlErr = codec.DecodeSqlExecuteResponse(resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sqlErr != nil {
+		return nil, newError(sqlErr)
+	}
+	return newResult(s, member, queryID, cbs, metadata, page, updateCount), nil
+}
+
+func (s *Service) fetch(ctx context.Context, member *pubcluster.MemberInfo, queryID codec.SqlQueryId, cursorBufferSize int32) (*codec.SqlPage, error) {
+	req := codec.EncodeSqlFetchRequest(queryID, cursorBufferSize)
+	resp, err := s.invokeOnMember(ctx, req, member)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		page   *codec.SqlPage
+		sqlErr *codec.SqlError
+	)
+	if err := decode(func() { page, sqlErr = codec.DecodeSqlFetchResponse(resp) }); err != nil {
+		return nil, err
+	}
+	if sqlErr != nil {
+		return nil, newError(sqlErr)
+	}
+	return page, nil
+}
+
+func (s *Service) close(ctx context.Context, member *pubcluster.MemberInfo, queryID codec.SqlQueryId) error {
+	req := codec.EncodeSqlCloseRequest(queryID)
+	_, err := s.invokeOnMember(ctx, req, member)
+	return err
+}
+
+func (s *Service) invokeOnMember(ctx context.Context, req *proto.ClientMessage, member *pubcluster.MemberInfo) (*proto.ClientMessage, error) {
+	inv := s.invocationFactory.NewMemberBoundInvocation(req, member, time.Now())
+	if err := s.invocationService.SendRequest(ctx, inv); err != nil {
+		return nil, err
+	}
+	return inv.GetWithContext(ctx)
+}
+
+func (s *Service) randomDataMember() *pubcluster.MemberInfo {
+	var members []pubcluster.MemberInfo
+	for _, mem := range s.clusterService.OrderedMembers() {
+		if !mem.LiteMember {
+			members = append(members, mem)
+		}
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	return &members[rand.Intn(len(members))]
+}
+
+func (s *Service) newQueryID() codec.SqlQueryId {
+	localID := types.NewUUID()
+	return codec.SqlQueryId{
+		MemberIDHigh: int64(s.clientUUID.MostSignificantBits()),
+		MemberIDLow:  int64(s.clientUUID.LeastSignificantBits()),
+		LocalIDHigh:  int64(localID.MostSignificantBits()),
+		LocalIDLow:   int64(localID.LeastSignificantBits()),
+	}
+}
+
+func (s *Service) toObject(value interface{}) (interface{}, error) {
+	if data, ok := value.(*iserialization.Data); ok {
+		return s.ss.ToObject(data)
+	}
+	return value, nil
+}
+
+func timeoutMillis(timeout time.Duration) int64 {
+	if timeout == 0 {
+		return timeoutNotSet
+	}
+	if timeout < 0 {
+		return timeoutDisabled
+	}
+	return timeout.Milliseconds()
+}
+
+// decode runs the given decoder function, converting a panic to an error.
+// The page decoder panics if the response contains a column type which is not known to the client.
+func decode(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ihzerrors.NewSerializationError(fmt.Sprintf("decoding SQL response: %v", r), nil)
+		}
+	}()
+	f()
+	return nil
+}
+
+func newError(e *codec.SqlError) *sql.Error {
+	return &sql.Error{
+		Code:                sql.ErrorCode(e.Code),
+		Message:             e.Message,
+		Suggestion:          e.Suggestion,
+		OriginatingMemberID: e.OriginatingMemberId,
+	}
+}
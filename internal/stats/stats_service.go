@@ -39,6 +39,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/internal/event"
 	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
 	"github.com/hazelcast/hazelcast-go-client/internal/logger"
+	"github.com/hazelcast/hazelcast-go-client/internal/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 )
 
@@ -62,6 +63,7 @@ type Service struct {
 	invocationService  *invocation.Service
 	doneCh             chan struct{}
 	ed                 *event.DispatchService
+	ncm                *nearcache.Manager
 	btStats            binTextStats
 	clientName         string
 	gauges             []gauge
@@ -72,6 +74,7 @@ func NewService(
 	invService *invocation.Service,
 	invFactory *cluster.ConnectionInvocationFactory,
 	ed *event.DispatchService,
+	ncm *nearcache.Manager,
 	logger logger.Logger,
 	interval time.Duration,
 	clientName string) *Service {
@@ -85,6 +88,7 @@ func NewService(
 		mu:                &sync.RWMutex{},
 		clientName:        clientName,
 		ed:                ed,
+		ncm:               ncm,
 		btStats:           binTextStats{mc: NewMetricCompressor()},
 	}
 	s.clusterConnectTime.Store(time.Now())
@@ -178,6 +182,9 @@ func (s *Service) addGauges() {
 		newGaugeRuntime(s.logger),
 		newGaugeOS(s.logger),
 	}
+	if s.ncm != nil {
+		s.gauges = append(s.gauges, newGaugeNearCache(s.ncm))
+	}
 }
 
 func makeStatString(ss []stat) string {
@@ -406,6 +413,47 @@ func (g gaugeOS) updateDescr(bt *binTextStats) {
 	}
 }
 
+type gaugeNearCache struct {
+	ncm *nearcache.Manager
+}
+
+func newGaugeNearCache(ncm *nearcache.Manager) gaugeNearCache {
+	return gaugeNearCache{ncm: ncm}
+}
+
+func (g gaugeNearCache) Update(bt *binTextStats) {
+	for _, st := range g.ncm.Stats() {
+		prefix := "nc." + escapeStatName(st.Name)
+		add := func(md metricDescriptor, value int64) {
+			md.Discriminator = "name"
+			md.DiscriminatorValue = st.Name
+			bt.mc.AddLong(md, value)
+			bt.stats = append(bt.stats, stat{k: fmt.Sprintf("%s.%s", prefix, md.Metric), v: strconv.FormatInt(value, 10)})
+		}
+		add(makeMSMD("nearcache", "creationTime"), st.CreationTime.UnixNano()/int64(time.Millisecond))
+		add(makeCountMD("nearcache", "ownedEntryCount"), st.OwnedEntryCount)
+		add(makeCountMD("nearcache", "hits"), st.Hits)
+		add(makeCountMD("nearcache", "misses"), st.Misses)
+		add(makeCountMD("nearcache", "evictions"), st.Evictions)
+		add(makeCountMD("nearcache", "expirations"), st.Expirations)
+		add(makeCountMD("nearcache", "invalidations"), st.Invalidations)
+		add(makeCountMD("nearcache", "invalidationRequests"), st.InvalidationRequests)
+	}
+}
+
+// escapeStatName escapes the characters which have a special meaning in the text statistics.
+func escapeStatName(name string) string {
+	sb := strings.Builder{}
+	for _, r := range name {
+		switch r {
+		case ',', '=', '.', ':', '\\':
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
 func makeBytesMD(prefix, metric string) metricDescriptor {
 	return metricDescriptor{
 		Prefix:  prefix,
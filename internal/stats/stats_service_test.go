@@ -47,7 +47,7 @@ func TestNewService(t *testing.T) {
 	invService := invocation.NewService(handler, ed, lg)
 	config := hazelcast.Config{}
 	invFac := cluster.NewConnectionInvocationFactory(&config.Cluster)
-	srv := stats.NewService(invService, invFac, ed, lg, 100*time.Millisecond, "hz1")
+	srv := stats.NewService(invService, invFac, ed, nil, lg, 100*time.Millisecond, "hz1")
 	srv.Start()
 	address := pubcluster.NewAddress("100.200.300.400", 12345)
 	ed.Publish(cluster.NewConnected(address))
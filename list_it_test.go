@@ -18,6 +18,7 @@ package hazelcast_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sync/atomic"
@@ -26,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
 	"github.com/hazelcast/hazelcast-go-client/internal/it/runtime"
 )
@@ -471,3 +473,95 @@ func TestList_SetWithoutItem(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestList_Iterator(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		var target []interface{}
+		for i := 0; i < 250; i++ {
+			target = append(target, fmt.Sprintf("item-%d", i))
+		}
+		it.MustValue(l.AddAll(ctx, target...))
+		iter, err := l.Iterator(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var items []interface{}
+		for it.MustBool(iter.HasNext(ctx)) {
+			items = append(items, it.MustValue(iter.Next(ctx)))
+		}
+		assert.Equal(t, target, items)
+		_, err = iter.Next(ctx)
+		assert.True(t, errors.Is(err, hzerrors.ErrNoSuchElement))
+	})
+}
+
+func TestList_ListIterator(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		var target []interface{}
+		for i := 0; i < 250; i++ {
+			target = append(target, int64(i))
+		}
+		it.MustValue(l.AddAll(ctx, target...))
+		iter, err := l.ListIterator(ctx, 180)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, 180, iter.NextIndex())
+		assert.Equal(t, int64(180), it.MustValue(iter.Next(ctx)))
+		// walk backwards over the batch boundaries to the beginning of the list
+		for i := 180; i >= 0; i-- {
+			assert.Equal(t, i, iter.PreviousIndex())
+			assert.Equal(t, int64(i), it.MustValue(iter.Previous(ctx)))
+		}
+		assert.False(t, it.MustBool(iter.HasPrevious(ctx)))
+		assert.Equal(t, -1, iter.PreviousIndex())
+		_, err = iter.Previous(ctx)
+		assert.True(t, errors.Is(err, hzerrors.ErrNoSuchElement))
+		// and forwards again to the end
+		for i := 0; i < 250; i++ {
+			assert.Equal(t, int64(i), it.MustValue(iter.Next(ctx)))
+		}
+		assert.False(t, it.MustBool(iter.HasNext(ctx)))
+	})
+}
+
+func TestList_IteratorConcurrentRemoval(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		var target []interface{}
+		for i := 0; i < 250; i++ {
+			target = append(target, int64(i))
+		}
+		it.MustValue(l.AddAll(ctx, target...))
+		iter, err := l.Iterator(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the first batch is already fetched, shrink the list below the end of the next batch
+		it.MustValue(l.RemoveAll(ctx, target[150:]...))
+		var items []interface{}
+		for it.MustBool(iter.HasNext(ctx)) {
+			items = append(items, it.MustValue(iter.Next(ctx)))
+		}
+		assert.Equal(t, target[:150], items)
+	})
+}
+
+func TestList_ListIteratorInvalidIndex(t *testing.T) {
+	it.ListTester(t, func(t *testing.T, l *hz.List) {
+		ctx := context.Background()
+		it.MustValue(l.AddAll(ctx, "a", "b"))
+		_, err := l.ListIterator(ctx, 3)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds))
+		_, err = l.ListIterator(ctx, -1)
+		assert.True(t, errors.Is(err, hzerrors.ErrIndexOutOfBounds))
+		iter, err := l.ListIterator(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.False(t, it.MustBool(iter.HasNext(ctx)))
+		assert.Equal(t, "b", it.MustValue(iter.Previous(ctx)))
+	})
+}
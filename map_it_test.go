@@ -33,6 +33,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
 	"github.com/hazelcast/hazelcast-go-client/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
@@ -1095,6 +1096,204 @@ func TestMap_ExecuteOnEntriesWithPredicate(t *testing.T) {
 	})
 }
 
+func TestMap_ExecuteOnKey(t *testing.T) {
+	cb := func(c *hz.Config) {
+		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
+	}
+	it.MapTesterWithConfig(t, cb, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "v1"))
+		it.MustValue(m.Put(ctx, "k2", "v2"))
+		v, err := m.ExecuteOnKey(ctx, &SimpleEntryProcessor{value: "test"}, "k1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "test", v)
+		it.AssertEquals(t, "test", it.MustValue(m.Get(ctx, "k1")))
+		it.AssertEquals(t, "v2", it.MustValue(m.Get(ctx, "k2")))
+	})
+}
+
+func TestMap_ExecuteOnKeys(t *testing.T) {
+	cb := func(c *hz.Config) {
+		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
+	}
+	it.MapTesterWithConfig(t, cb, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "v1"))
+		it.MustValue(m.Put(ctx, "k2", "v2"))
+		it.MustValue(m.Put(ctx, "k3", "v3"))
+		vs, err := m.ExecuteOnKeys(ctx, &SimpleEntryProcessor{value: "test"}, "k1", "k2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Slice(vs, func(i, j int) bool {
+			return vs[i].Key.(string) < vs[j].Key.(string)
+		})
+		target := []types.Entry{{Key: "k1", Value: "test"}, {Key: "k2", Value: "test"}}
+		assert.Equal(t, target, vs)
+		it.AssertEquals(t, "v3", it.MustValue(m.Get(ctx, "k3")))
+	})
+}
+
+func TestMap_SubmitToKey(t *testing.T) {
+	cb := func(c *hz.Config) {
+		c.Serialization.SetIdentifiedDataSerializableFactories(&SimpleEntryProcessorFactory{})
+	}
+	it.MapTesterWithConfig(t, cb, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "v1"))
+		f, err := m.SubmitToKey(ctx, &SimpleEntryProcessor{value: "test"}, "k1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := f.Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "test", v)
+		it.AssertEquals(t, "test", it.MustValue(m.Get(ctx, "k1")))
+	})
+}
+
+func TestMap_GetValuesWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 20; i++ {
+			it.MustValue(m.Put(ctx, fmt.Sprintf("k%02d", i), int32(i)))
+		}
+		pred := predicate.Paging(nil, 5, nil)
+		it.AssertEquals(t, []interface{}{int32(0), int32(1), int32(2), int32(3), int32(4)}, it.MustValue(m.GetValuesWithPredicate(ctx, pred)))
+		pred.NextPage()
+		it.AssertEquals(t, []interface{}{int32(5), int32(6), int32(7), int32(8), int32(9)}, it.MustValue(m.GetValuesWithPredicate(ctx, pred)))
+		pred.SetPage(3)
+		it.AssertEquals(t, []interface{}{int32(15), int32(16), int32(17), int32(18), int32(19)}, it.MustValue(m.GetValuesWithPredicate(ctx, pred)))
+		pred.PreviousPage()
+		it.AssertEquals(t, []interface{}{int32(10), int32(11), int32(12), int32(13), int32(14)}, it.MustValue(m.GetValuesWithPredicate(ctx, pred)))
+		pred.SetPage(4)
+		it.AssertEquals(t, []interface{}{}, it.MustValue(m.GetValuesWithPredicate(ctx, pred)))
+	})
+}
+
+func TestMap_GetKeySetAndEntrySetWithPagingPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 20; i++ {
+			it.MustValue(m.Put(ctx, fmt.Sprintf("k%02d", i), int32(i)))
+		}
+		pred := predicate.Paging(predicate.GreaterOrEqual("this", int32(10)), 3, nil)
+		it.AssertEquals(t, []interface{}{"k10", "k11", "k12"}, it.MustValue(m.GetKeySetWithPredicate(ctx, pred)))
+		pred.NextPage()
+		target := []types.Entry{
+			types.NewEntry("k13", int32(13)),
+			types.NewEntry("k14", int32(14)),
+			types.NewEntry("k15", int32(15)),
+		}
+		it.AssertEquals(t, target, it.MustValue(m.GetEntrySetWithPredicate(ctx, pred)))
+		if _, err := m.ExecuteOnEntriesWithPredicate(ctx, &SimpleEntryProcessor{value: "test"}, pred); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
+func TestMap_GetValuesWithPartitionPredicate(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := 0; i < 20; i++ {
+			it.MustValue(m.Put(ctx, fmt.Sprintf("k%02d", i), int32(i)))
+		}
+		it.AssertEquals(t, []interface{}{int32(5)}, it.MustValue(m.GetValuesWithPredicate(ctx, predicate.Partition("k05", predicate.Equal("this", int32(5))))))
+		values := it.MustValue(m.GetValuesWithPredicate(ctx, predicate.Partition("k05", predicate.True()))).([]interface{})
+		assert.Contains(t, values, int32(5))
+		assert.LessOrEqual(t, len(values), 20)
+		keys := it.MustValue(m.GetKeySetWithPredicate(ctx, predicate.Partition("k05", predicate.Paging(nil, 1, nil)))).([]interface{})
+		assert.Len(t, keys, 1)
+	})
+}
+
+func TestMap_IterateEntries(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const entryCount = 1000
+		target := map[interface{}]interface{}{}
+		for i := 0; i < entryCount; i++ {
+			key := fmt.Sprintf("k%d", i)
+			value := fmt.Sprintf("v%d", i)
+			it.MustValue(m.Put(ctx, key, value))
+			target[key] = value
+		}
+		iter, err := m.IterateEntries(ctx, 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries := map[interface{}]interface{}{}
+		for {
+			ok, err := iter.HasNext(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				break
+			}
+			entry, err := iter.Next(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, found := entries[entry.Key]; found {
+				t.Fatalf("duplicate key: %v", entry.Key)
+			}
+			entries[entry.Key] = entry.Value
+		}
+		assert.Equal(t, target, entries)
+		if _, err := iter.Next(ctx); !errors.Is(err, hzerrors.ErrNoSuchElement) {
+			t.Fatalf("expected ErrNoSuchElement, got: %v", err)
+		}
+	})
+}
+
+func TestMap_IterateKeys(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const entryCount = 1000
+		var target []string
+		for i := 0; i < entryCount; i++ {
+			key := fmt.Sprintf("k%d", i)
+			it.MustValue(m.Put(ctx, key, i))
+			target = append(target, key)
+		}
+		iter, err := m.IterateKeys(ctx, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for {
+			ok, err := iter.HasNext(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				break
+			}
+			key, err := iter.Next(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			keys = append(keys, key.(string))
+		}
+		sort.Strings(target)
+		sort.Strings(keys)
+		assert.Equal(t, target, keys)
+	})
+}
+
+func TestMap_IterateEntries_InvalidBatchSize(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		if _, err := m.IterateEntries(context.Background(), 0); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected ErrIllegalArgument, got: %v", err)
+		}
+	})
+}
+
 func TestMap_AddInterceptor(t *testing.T) {
 	it.MapTester(t, func(t *testing.T, m *hz.Map) {
 		ctx := context.Background()
@@ -1372,3 +1571,75 @@ func (m MapGetInterceptor) WriteData(output serialization.DataOutput) {
 func (m *MapGetInterceptor) ReadData(input serialization.DataInput) {
 	m.Prefix = input.ReadString()
 }
+
+func TestMap_NearCacheGetAfterUpdate(t *testing.T) {
+	mapName := it.NewUniqueObjectName("map", "near-cache")
+	cbCallback := func(config *hz.Config) {
+		it.Must(config.AddNearCache(mapName, nearcache.Config{}))
+	}
+	it.TesterWithConfigBuilder(t, cbCallback, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		m, err := client.GetMap(ctx, mapName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer m.Destroy(ctx)
+		it.MustValue(m.Put(ctx, "k1", "v1"))
+		it.MustValue(m.Put(ctx, "k2", "v2"))
+		assert.Equal(t, "v1", it.MustValue(m.Get(ctx, "k1")))
+		assert.Equal(t, "v1", it.MustValue(m.Get(ctx, "k1")))
+		it.MustValue(m.Put(ctx, "k1", "v11"))
+		assert.Equal(t, "v11", it.MustValue(m.Get(ctx, "k1")))
+		entries, err := m.GetAll(ctx, "k1", "k2", "k3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.ElementsMatch(t, []types.Entry{types.NewEntry("k1", "v11"), types.NewEntry("k2", "v2")}, entries)
+		it.MustValue(m.Remove(ctx, "k2"))
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "k2")))
+		it.Must(m.Clear(ctx))
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "k1")))
+	})
+}
+
+func TestMap_NearCacheInvalidatedByOtherClient(t *testing.T) {
+	mapName := it.NewUniqueObjectName("map", "near-cache")
+	var otherConfig hz.Config
+	cbCallback := func(config *hz.Config) {
+		otherConfig = config.Clone()
+		it.Must(config.AddNearCache(mapName, nearcache.Config{}))
+	}
+	it.TesterWithConfigBuilder(t, cbCallback, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		m, err := client.GetMap(ctx, mapName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer m.Destroy(ctx)
+		other := it.MustClient(hz.StartNewClientWithConfig(ctx, otherConfig))
+		defer other.Shutdown(ctx)
+		otherMap, err := other.GetMap(ctx, mapName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.MustValue(m.Put(ctx, "k", "v1"))
+		assert.Equal(t, "v1", it.MustValue(m.Get(ctx, "k")))
+		it.MustValue(otherMap.Put(ctx, "k", "v2"))
+		it.Eventually(t, func() bool {
+			return it.MustValue(m.Get(ctx, "k")) == "v2"
+		})
+	})
+}
+
+func TestMap_AddRemovePartitionLostListener(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		subscriptionID, err := m.AddPartitionLostListener(ctx, func(event *hz.MapPartitionLost) {})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := m.RemovePartitionLostListener(ctx, subscriptionID); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -123,6 +123,39 @@ func TestMultiMap_Remove(t *testing.T) {
 	})
 }
 
+func TestMultiMap_RemoveEntry(t *testing.T) {
+	it.MultiMapTester(t, func(t *testing.T, m *hz.MultiMap) {
+		ctx := context.Background()
+		assert.True(t, it.MustBool(m.Put(ctx, "key", "v1")), "multi-map put failed")
+		assert.True(t, it.MustBool(m.Put(ctx, "key", "v2")), "multi-map put failed")
+		assert.False(t, it.MustBool(m.RemoveEntry(ctx, "key", "v3")))
+		assert.True(t, it.MustBool(m.RemoveEntry(ctx, "key", "v1")))
+		assert.Equal(t, []interface{}{"v2"}, it.MustSlice(m.Get(ctx, "key")))
+	})
+}
+
+func TestMultiMap_ContainsEntry(t *testing.T) {
+	it.MultiMapTester(t, func(t *testing.T, m *hz.MultiMap) {
+		ctx := context.Background()
+		assert.False(t, it.MustBool(m.ContainsEntry(ctx, "key", "v1")))
+		assert.True(t, it.MustBool(m.Put(ctx, "key", "v1")), "multi-map put failed")
+		assert.True(t, it.MustBool(m.ContainsEntry(ctx, "key", "v1")))
+		assert.False(t, it.MustBool(m.ContainsEntry(ctx, "key", "v2")))
+		assert.False(t, it.MustBool(m.ContainsEntry(ctx, "other-key", "v1")))
+	})
+}
+
+func TestMultiMap_ValueCount(t *testing.T) {
+	it.MultiMapTester(t, func(t *testing.T, m *hz.MultiMap) {
+		ctx := context.Background()
+		assert.Equal(t, 0, it.MustValue(m.ValueCount(ctx, "key")))
+		it.MustValue(m.Put(ctx, "key", "v1"))
+		it.MustValue(m.Put(ctx, "key", "v2"))
+		it.MustValue(m.Put(ctx, "other-key", "v3"))
+		assert.Equal(t, 2, it.MustValue(m.ValueCount(ctx, "key")))
+	})
+}
+
 func TestMultiMap_GetKeySet(t *testing.T) {
 	it.MultiMapTester(t, func(t *testing.T, m *hz.MultiMap) {
 		ctx := context.Background()
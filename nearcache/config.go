@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nearcache
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// DefaultEvictionSize is the default maximum number of entries in a Near Cache.
+	DefaultEvictionSize = 10_000
+)
+
+// EvictionPolicy determines which entries are removed when the Near Cache is full.
+type EvictionPolicy int32
+
+const (
+	// EvictionPolicyLRU removes the least recently used entry.
+	EvictionPolicyLRU EvictionPolicy = iota
+	// EvictionPolicyLFU removes the least frequently used entry.
+	EvictionPolicyLFU
+	// EvictionPolicyNone does not remove any entries. New entries are not added once the Near Cache is full.
+	EvictionPolicyNone
+	// EvictionPolicyRandom removes a random entry.
+	EvictionPolicyRandom
+)
+
+func (p *EvictionPolicy) UnmarshalText(b []byte) error {
+	text := string(b)
+	switch text {
+	case "LRU":
+		*p = EvictionPolicyLRU
+	case "LFU":
+		*p = EvictionPolicyLFU
+	case "NONE":
+		*p = EvictionPolicyNone
+	case "RANDOM":
+		*p = EvictionPolicyRandom
+	default:
+		return fmt.Errorf("invalid eviction policy %s: %w", text, hzerrors.ErrIllegalArgument)
+	}
+	return nil
+}
+
+func (p EvictionPolicy) MarshalText() ([]byte, error) {
+	switch p {
+	case EvictionPolicyLRU:
+		return []byte("LRU"), nil
+	case EvictionPolicyLFU:
+		return []byte("LFU"), nil
+	case EvictionPolicyNone:
+		return []byte("NONE"), nil
+	case EvictionPolicyRandom:
+		return []byte("RANDOM"), nil
+	}
+	return nil, hzerrors.ErrIllegalArgument
+}
+
+// InMemoryFormat determines how the values are stored in the Near Cache.
+type InMemoryFormat int32
+
+const (
+	// InMemoryFormatBinary stores values in serialized form.
+	// Values are deserialized on every read, so the caller always receives a fresh copy.
+	InMemoryFormatBinary InMemoryFormat = iota
+	// InMemoryFormatObject stores values in deserialized form.
+	// The same value instance is returned for subsequent reads, so it must not be modified.
+	InMemoryFormatObject
+)
+
+func (f *InMemoryFormat) UnmarshalText(b []byte) error {
+	text := string(b)
+	switch text {
+	case "BINARY":
+		*f = InMemoryFormatBinary
+	case "OBJECT":
+		*f = InMemoryFormatObject
+	default:
+		return fmt.Errorf("invalid in-memory format %s: %w", text, hzerrors.ErrIllegalArgument)
+	}
+	return nil
+}
+
+func (f InMemoryFormat) MarshalText() ([]byte, error) {
+	switch f {
+	case InMemoryFormatBinary:
+		return []byte("BINARY"), nil
+	case InMemoryFormatObject:
+		return []byte("OBJECT"), nil
+	}
+	return nil, hzerrors.ErrIllegalArgument
+}
+
+// Config contains the configuration for a Near Cache.
+type Config struct {
+	// Eviction contains the eviction configuration.
+	Eviction EvictionConfig
+	// TimeToLive is the maximum duration an entry is kept in the Near Cache after it is added.
+	// Zero means entries are kept until they are invalidated or evicted.
+	TimeToLive types.Duration `json:",omitempty"`
+	// MaxIdle is the maximum duration an entry is kept in the Near Cache without being read.
+	// Zero means entries are kept until they are invalidated or evicted.
+	MaxIdle types.Duration `json:",omitempty"`
+	// InMemoryFormat determines how the values are stored. Defaults to InMemoryFormatBinary.
+	InMemoryFormat InMemoryFormat `json:",omitempty"`
+}
+
+func (c Config) Clone() Config {
+	return c
+}
+
+// Validate validates the configuration and replaces missing configuration with defaults.
+func (c *Config) Validate() error {
+	if err := check.NonNegativeDuration(&c.TimeToLive, 0, "invalid time to live"); err != nil {
+		return err
+	}
+	if err := check.NonNegativeDuration(&c.MaxIdle, 0, "invalid max idle"); err != nil {
+		return err
+	}
+	if err := c.Eviction.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EvictionConfig contains the eviction configuration for a Near Cache.
+type EvictionConfig struct {
+	// Policy determines which entries are evicted when the Near Cache is full. Defaults to EvictionPolicyLRU.
+	Policy EvictionPolicy `json:",omitempty"`
+	// Size is the maximum number of entries in the Near Cache. Defaults to 10_000.
+	Size int32 `json:",omitempty"`
+}
+
+// Validate validates the eviction configuration and replaces missing configuration with defaults.
+func (c *EvictionConfig) Validate() error {
+	if c.Size == 0 {
+		c.Size = DefaultEvictionSize
+	} else if c.Size < 0 {
+		return fmt.Errorf("invalid eviction size: %w", hzerrors.ErrIllegalArgument)
+	}
+	return nil
+}
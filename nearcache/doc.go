@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+Package nearcache contains the configuration for the client-side Near Cache.
+
+A Near Cache keeps recently read entries of a Map or ReplicatedMap in client memory, so subsequent reads of the same keys do not require a network round trip.
+Entries in the Near Cache are invalidated by the invalidation events sent by the cluster members whenever an entry changes.
+Near Cache is configured using the name of the Map or ReplicatedMap.
+The configuration applies to both the Map and the ReplicatedMap with that name, each of them having its own Near Cache:
+
+	config := hazelcast.Config{}
+	ncc := nearcache.Config{
+		TimeToLive: types.Duration(5 * time.Minute),
+	}
+	ncc.Eviction.Policy = nearcache.EvictionPolicyLFU
+	ncc.Eviction.Size = 50_000
+	if err := config.AddNearCache("my-map", ncc); err != nil {
+		// handle the error
+	}
+
+Near Cache is eventually consistent.
+Reads may return stale values until the invalidation event for an updated entry is received from the cluster.
+*/
+package nearcache
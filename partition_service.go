@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// PartitionService provides access to the partition related events of the cluster.
+// Use Client.PartitionService to get it.
+type PartitionService struct {
+	client *Client
+}
+
+func newPartitionService(client *Client) *PartitionService {
+	return &PartitionService{client: client}
+}
+
+// AddPartitionLostListener adds a listener which is called when a partition is lost, regardless of the data structures it holds.
+// The listener is registered again when the client reconnects to the cluster.
+// Returns the subscription ID of the listener.
+func (s *PartitionService) AddPartitionLostListener(ctx context.Context, handler PartitionLostHandler) (types.UUID, error) {
+	if atomic.LoadInt32(&s.client.state) >= stopping {
+		return types.UUID{}, hzerrors.ErrClientNotActive
+	}
+	return s.client.proxyManager.addPartitionLostListener(ctx, handler)
+}
+
+// RemovePartitionLostListener removes the partition lost listener with the given subscription ID.
+func (s *PartitionService) RemovePartitionLostListener(ctx context.Context, subscriptionID types.UUID) error {
+	if atomic.LoadInt32(&s.client.state) >= stopping {
+		return hzerrors.ErrClientNotActive
+	}
+	return s.client.proxyManager.removePartitionLostListener(ctx, subscriptionID)
+}
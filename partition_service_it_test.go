@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestPartitionService_AddRemovePartitionLostListener(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		ps := client.PartitionService()
+		subscriptionID, err := ps.AddPartitionLostListener(ctx, func(event *hz.PartitionLost) {})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ps.RemovePartitionLostListener(ctx, subscriptionID); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestPartitionService_AddPartitionLostListenerAfterShutdown(t *testing.T) {
+	tc := it.StartNewClusterWithOptions("partition-lost-after-shutdown", 47701, 1)
+	defer tc.Shutdown()
+	ctx := context.Background()
+	client := it.MustClient(hz.StartNewClientWithConfig(ctx, tc.DefaultConfig()))
+	it.Must(client.Shutdown(ctx))
+	_, err := client.PartitionService().AddPartitionLostListener(ctx, func(event *hz.PartitionLost) {})
+	if !errors.Is(err, hzerrors.ErrClientNotActive) {
+		t.Fatalf("expected hzerrors.ErrClientNotActive but received: %v", err)
+	}
+}
+
+func TestPartitionService_PartitionLostEvents(t *testing.T) {
+	// terminating two members of a three member cluster at once loses the partitions
+	// which had both their primary replica and their only backup on those members.
+	const port = 48701
+	tc := it.StartNewClusterWithOptions("partition-lost-events", port, 3)
+	defer tc.Shutdown()
+	ctx := context.Background()
+	client := it.MustClient(hz.StartNewClientWithConfig(ctx, tc.DefaultConfig()))
+	defer client.Shutdown(ctx)
+	mapName := it.NewUniqueObjectName("partition-lost")
+	m := it.MustValue(client.GetMap(ctx, mapName)).(*hz.Map)
+	for i := 0; i < 1000; i++ {
+		it.MustValue(m.Put(ctx, i, i))
+	}
+	mu := &sync.Mutex{}
+	var lost []*hz.PartitionLost
+	var mapLost []*hz.MapPartitionLost
+	it.MustValue(client.PartitionService().AddPartitionLostListener(ctx, func(event *hz.PartitionLost) {
+		mu.Lock()
+		lost = append(lost, event)
+		mu.Unlock()
+	}))
+	it.MustValue(m.AddPartitionLostListener(ctx, func(event *hz.MapPartitionLost) {
+		mu.Lock()
+		mapLost = append(mapLost, event)
+		mu.Unlock()
+	}))
+	for _, memberUUID := range tc.MemberUUIDs[1:] {
+		it.MustBool(tc.RC.TerminateMember(ctx, tc.ClusterID, memberUUID))
+	}
+	it.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(lost) > 0 && len(mapLost) > 0
+	})
+	mu.Lock()
+	defer mu.Unlock()
+	for _, e := range lost {
+		assert.GreaterOrEqual(t, e.PartitionID, int32(0))
+		assert.GreaterOrEqual(t, e.LostBackupCount, 0)
+		assert.Equal(t, tc.MemberUUIDs[0], e.Member.UUID.String())
+	}
+	for _, e := range mapLost {
+		assert.Equal(t, mapName, e.MapName)
+		assert.GreaterOrEqual(t, e.PartitionID, int32(0))
+		assert.Equal(t, tc.MemberUUIDs[0], e.Member.UUID.String())
+	}
+}
@@ -24,5 +24,15 @@ How Distributed Query Works
 3. The predicate requester merges all the results coming from each member into a single set.
 
 Distributed query is highly scalable. If you add new members to the cluster, the partition count for each member is reduced and thus the time spent by each member on iterating its entries is reduced. In addition, the pool of partition threads evaluates the entries concurrently in each member, and the network traffic is also reduced since only filtered data is sent to the requester.
+
+Paging Predicate
+
+The results of a query can be returned page by page using a paging predicate.
+See Paging for the details.
+
+Partition Predicate
+
+A query can be restricted to a single partition using a partition predicate, so it is sent only to the member which owns that partition.
+See Partition for the details.
 */
 package predicate
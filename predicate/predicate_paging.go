@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package predicate
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+Paging creates a predicate which makes the queries return the results page by page.
+
+The results are filtered with the inner predicate, which may be nil to match all entries.
+The results are sorted using the given comparator, which must be an IdentifiedDataSerializable with a corresponding implementation available on the members.
+If the comparator is nil, the results are sorted by their natural order, which requires keys or values to be comparable on the member side.
+
+A paging predicate keeps track of the last entry of each page it has returned, the anchors, so the next page can be computed efficiently on the members.
+The same paging predicate must be used to navigate the pages of the same query, and it is not concurrency-safe.
+
+	pred := predicate.Paging(predicate.Greater("age", 20), 10, nil)
+	firstPage, err := m.GetValuesWithPredicate(ctx, pred)
+	pred.NextPage()
+	secondPage, err := m.GetValuesWithPredicate(ctx, pred)
+
+Paging predicates are supported only by Map.GetKeySetWithPredicate, Map.GetValuesWithPredicate and Map.GetEntrySetWithPredicate.
+*/
+func Paging(inner Predicate, pageSize int, comparator serialization.IdentifiedDataSerializable) *PagingPredicate {
+	return &PagingPredicate{
+		predicate:  inner,
+		comparator: comparator,
+		pageSize:   pageSize,
+	}
+}
+
+// PagingAnchor is the last entry of a page.
+// The next page starts after the anchor.
+type PagingAnchor struct {
+	Entry types.Entry
+	Page  int
+}
+
+// PagingPredicate is a predicate which makes the queries return the results page by page.
+// See Paging for the details.
+type PagingPredicate struct {
+	predicate  Predicate
+	comparator serialization.IdentifiedDataSerializable
+	anchors    []PagingAnchor
+	page       int
+	pageSize   int
+}
+
+func (p PagingPredicate) FactoryID() int32 {
+	return factoryID
+}
+
+func (p PagingPredicate) ClassID() int32 {
+	return 15
+}
+
+func (p *PagingPredicate) ReadData(input serialization.DataInput) {
+	if pred := input.ReadObject(); pred != nil {
+		p.predicate = pred.(Predicate)
+	}
+	if comp := input.ReadObject(); comp != nil {
+		p.comparator = comp.(serialization.IdentifiedDataSerializable)
+	}
+	p.page = int(input.ReadInt32())
+	p.pageSize = int(input.ReadInt32())
+	// iteration type is set by the query, not by the predicate
+	input.ReadString()
+	length := int(input.ReadInt32())
+	p.anchors = make([]PagingAnchor, length)
+	for i := 0; i < length; i++ {
+		page := int(input.ReadInt32())
+		key := input.ReadObject()
+		value := input.ReadObject()
+		p.anchors[i] = PagingAnchor{Page: page, Entry: types.NewEntry(key, value)}
+	}
+}
+
+func (p PagingPredicate) WriteData(output serialization.DataOutput) {
+	output.WriteObject(p.predicate)
+	output.WriteObject(p.comparator)
+	output.WriteInt32(int32(p.page))
+	output.WriteInt32(int32(p.pageSize))
+	output.WriteString("ENTRY")
+	output.WriteInt32(int32(len(p.anchors)))
+	for _, anchor := range p.anchors {
+		output.WriteInt32(int32(anchor.Page))
+		output.WriteObject(anchor.Entry.Key)
+		output.WriteObject(anchor.Entry.Value)
+	}
+}
+
+func (p PagingPredicate) String() string {
+	inner := "nil"
+	if p.predicate != nil {
+		inner = p.predicate.String()
+	}
+	return fmt.Sprintf("Paging(%s, pageSize=%d, page=%d)", inner, p.pageSize, p.page)
+}
+
+// Predicate returns the inner predicate.
+func (p *PagingPredicate) Predicate() Predicate {
+	return p.predicate
+}
+
+// Comparator returns the comparator used for sorting the results.
+func (p *PagingPredicate) Comparator() serialization.IdentifiedDataSerializable {
+	return p.comparator
+}
+
+// PageSize returns the maximum number of results in a page.
+func (p *PagingPredicate) PageSize() int {
+	return p.pageSize
+}
+
+// Page returns the current page number.
+// Pages are numbered starting from 0.
+func (p *PagingPredicate) Page() int {
+	return p.page
+}
+
+// NextPage moves to the next page.
+func (p *PagingPredicate) NextPage() {
+	p.page++
+}
+
+// PreviousPage moves to the previous page.
+// It does nothing if the current page is the first page.
+func (p *PagingPredicate) PreviousPage() {
+	if p.page > 0 {
+		p.page--
+	}
+}
+
+// SetPage moves to the given page.
+// Negative page numbers are ignored.
+func (p *PagingPredicate) SetPage(page int) {
+	if page >= 0 {
+		p.page = page
+	}
+}
+
+// Reset moves to the first page and removes the anchors.
+func (p *PagingPredicate) Reset() {
+	p.page = 0
+	p.anchors = nil
+}
+
+// AnchorList returns the anchors of the pages returned so far, sorted by the page number.
+func (p *PagingPredicate) AnchorList() []PagingAnchor {
+	return p.anchors
+}
+
+// SetAnchorList replaces the anchors.
+// It is called by the queries with the anchors sent by the members, so it should not be called directly.
+func (p *PagingPredicate) SetAnchorList(anchors []PagingAnchor) {
+	p.anchors = anchors
+}
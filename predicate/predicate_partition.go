@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package predicate
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+/*
+Partition creates a predicate which restricts the query to the partition of the given partition key.
+Entries which match the inner predicate are returned only if they are in the partition which owns the partition key.
+
+The query is sent only to the member which owns the partition, instead of all members in the cluster.
+That is useful when all of the matching entries are known to share the same partition key.
+
+The inner predicate may be a paging predicate, in which case the pages are computed using only the entries of the partition.
+
+Partition predicates are supported only by Map.GetKeySetWithPredicate, Map.GetValuesWithPredicate and Map.GetEntrySetWithPredicate.
+*/
+func Partition(partitionKey interface{}, inner Predicate) *PartitionPredicate {
+	return &PartitionPredicate{
+		partitionKey: partitionKey,
+		predicate:    inner,
+	}
+}
+
+// PartitionPredicate is a predicate which restricts the query to a single partition.
+// See Partition for the details.
+type PartitionPredicate struct {
+	partitionKey interface{}
+	predicate    Predicate
+}
+
+func (p PartitionPredicate) FactoryID() int32 {
+	return factoryID
+}
+
+func (p PartitionPredicate) ClassID() int32 {
+	return 16
+}
+
+func (p *PartitionPredicate) ReadData(input serialization.DataInput) {
+	p.partitionKey = input.ReadObject()
+	if pred := input.ReadObject(); pred != nil {
+		p.predicate = pred.(Predicate)
+	}
+}
+
+func (p PartitionPredicate) WriteData(output serialization.DataOutput) {
+	output.WriteObject(p.partitionKey)
+	output.WriteObject(p.predicate)
+}
+
+func (p PartitionPredicate) String() string {
+	inner := "nil"
+	if p.predicate != nil {
+		inner = p.predicate.String()
+	}
+	return fmt.Sprintf("Partition(%v, %s)", p.partitionKey, inner)
+}
+
+// PartitionKey returns the partition key which determines the partition of the query.
+func (p *PartitionPredicate) PartitionKey() interface{} {
+	return p.partitionKey
+}
+
+// Predicate returns the inner predicate.
+func (p *PartitionPredicate) Predicate() Predicate {
+	return p.predicate
+}
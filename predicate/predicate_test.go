@@ -34,3 +34,33 @@ func TestAnd(t *testing.T) {
 	)
 	fmt.Println(p)
 }
+
+func TestPaging_PageNavigation(t *testing.T) {
+	p := predicate.Paging(predicate.Equal("foo", "bar"), 10, nil)
+	if p.Page() != 0 {
+		t.Fatalf("expected page 0, got %d", p.Page())
+	}
+	p.PreviousPage()
+	if p.Page() != 0 {
+		t.Fatalf("expected page 0, got %d", p.Page())
+	}
+	p.NextPage()
+	p.NextPage()
+	if p.Page() != 2 {
+		t.Fatalf("expected page 2, got %d", p.Page())
+	}
+	p.PreviousPage()
+	if p.Page() != 1 {
+		t.Fatalf("expected page 1, got %d", p.Page())
+	}
+	p.SetPage(5)
+	p.SetPage(-1)
+	if p.Page() != 5 {
+		t.Fatalf("expected page 5, got %d", p.Page())
+	}
+	p.SetAnchorList([]predicate.PagingAnchor{{Page: 0}})
+	p.Reset()
+	if p.Page() != 0 || len(p.AnchorList()) != 0 {
+		t.Fatalf("expected reset predicate, got page %d and %d anchors", p.Page(), len(p.AnchorList()))
+	}
+}
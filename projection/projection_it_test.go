@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projection_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
+)
+
+func TestSingleAttribute(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", &it.SamplePortable{A: "foo", B: 10}))
+		it.MustValue(m.Put(ctx, "k2", &it.SamplePortable{A: "bar", B: 30}))
+		it.MustValue(m.Put(ctx, "k3", &it.SamplePortable{A: "zoo", B: 30}))
+		result, err := m.Project(ctx, projection.SingleAttribute("A"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].(string) < result[j].(string)
+		})
+		assert.Equal(t, []interface{}{"bar", "foo", "zoo"}, result)
+	})
+}
+
+func TestMultiAttributeWithPredicate(t *testing.T) {
+	cbCallback := func(config *hz.Config) {
+		config.Serialization.SetPortableFactories(it.SamplePortableFactory{})
+	}
+	it.MapTesterWithConfig(t, cbCallback, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", &it.SamplePortable{A: "foo", B: 10}))
+		it.MustValue(m.Put(ctx, "k2", &it.SamplePortable{A: "bar", B: 30}))
+		it.MustValue(m.Put(ctx, "k3", &it.SamplePortable{A: "zoo", B: 30}))
+		result, err := m.ProjectWithPredicate(ctx, projection.MultiAttribute("A", "B"), predicate.Equal("B", int32(30)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].([]interface{})[0].(string) < result[j].([]interface{})[0].(string)
+		})
+		target := []interface{}{
+			[]interface{}{"bar", int32(30)},
+			[]interface{}{"zoo", int32(30)},
+		}
+		assert.Equal(t, target, result)
+	})
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package projection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/internal"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// MultiAttribute returns a projection which extracts the values of the given attribute paths.
+// The result of the projection for each entry is a []interface{} which contains the attribute values in the given order.
+func MultiAttribute(attributePaths ...string) *projMultiAttribute {
+	return &projMultiAttribute{attrPaths: attributePaths}
+}
+
+type projMultiAttribute struct {
+	attrPaths []string
+}
+
+func (p projMultiAttribute) FactoryID() int32 {
+	return internal.ProjectionFactoryID
+}
+
+func (p projMultiAttribute) ClassID() int32 {
+	return 1
+}
+
+func (p projMultiAttribute) WriteData(output serialization.DataOutput) {
+	output.WriteStringArray(p.attrPaths)
+}
+
+func (p *projMultiAttribute) ReadData(input serialization.DataInput) {
+	p.attrPaths = input.ReadStringArray()
+}
+
+func (p projMultiAttribute) String() string {
+	return fmt.Sprintf("MultiAttribute(%s)", strings.Join(p.attrPaths, ", "))
+}
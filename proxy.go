@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/rand"
 	"strings"
 	"time"
 
@@ -31,24 +32,40 @@ import (
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
 	ilogger "github.com/hazelcast/hazelcast-go-client/internal/logger"
+	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
 const (
-	ServiceNameMap              = "hz:impl:mapService"
-	ServiceNameReplicatedMap    = "hz:impl:replicatedMapService"
-	ServiceNameMultiMap         = "hz:impl:multiMapService"
-	ServiceNameQueue            = "hz:impl:queueService"
-	ServiceNameTopic            = "hz:impl:topicService"
-	ServiceNameList             = "hz:impl:listService"
-	ServiceNameSet              = "hz:impl:setService"
-	ServiceNamePNCounter        = "hz:impl:PNCounterService"
-	ServiceNameFlakeIDGenerator = "hz:impl:flakeIdGeneratorService"
+	ServiceNameMap                  = "hz:impl:mapService"
+	ServiceNameReplicatedMap        = "hz:impl:replicatedMapService"
+	ServiceNameMultiMap             = "hz:impl:multiMapService"
+	ServiceNameQueue                = "hz:impl:queueService"
+	ServiceNameTopic                = "hz:impl:topicService"
+	ServiceNameList                 = "hz:impl:listService"
+	ServiceNameSet                  = "hz:impl:setService"
+	ServiceNamePNCounter            = "hz:impl:PNCounterService"
+	ServiceNameFlakeIDGenerator     = "hz:impl:flakeIdGeneratorService"
+	ServiceNameRingbuffer           = "hz:impl:ringbufferService"
+	ServiceNameReliableTopic        = "hz:impl:reliableTopicService"
+	ServiceNameExecutorService      = "hz:impl:executorService"
+	ServiceNameDurableExecutor      = "hz:impl:durableExecutorService"
+	ServiceNameScheduledExecutor    = "hz:impl:scheduledExecutorService"
+	ServiceNameCardinalityEstimator = "hz:impl:cardinalityEstimatorService"
+	ServiceNameCache                = "hz:impl:cacheService"
+	ServiceNameAtomicLong           = "hz:raft:atomicLongService"
+	ServiceNameAtomicReference      = "hz:raft:atomicRefService"
+	ServiceNameFencedLock           = "hz:raft:lockService"
+	ServiceNameSemaphore            = "hz:raft:semaphoreService"
+	ServiceNameCountDownLatch       = "hz:raft:countDownLatchService"
+	ServiceNameCPMap                = "hz:raft:mapService"
 )
 
 const (
@@ -73,6 +90,7 @@ type creationBundle struct {
 	ClusterService       *cluster.Service
 	InvocationFactory    *cluster.ConnectionInvocationFactory
 	ListenerBinder       *cluster.ConnectionListenerBinder
+	NearCacheManager     *inearcache.Manager
 	Config               *Config
 	Logger               ilogger.Logger
 }
@@ -96,6 +114,9 @@ func (b creationBundle) Check() {
 	if b.ListenerBinder == nil {
 		panic("ListenerBinder is nil")
 	}
+	if b.NearCacheManager == nil {
+		panic("NearCacheManager is nil")
+	}
 	if b.Config == nil {
 		panic("Config is nil")
 	}
@@ -232,6 +253,14 @@ func (p *proxy) validateAndSerializeAggregate(agg aggregate.Aggregator) (arg1Dat
 	return
 }
 
+func (p *proxy) validateAndSerializeProjection(proj projection.Projection) (arg1Data *iserialization.Data, err error) {
+	if check.Nil(proj) {
+		return nil, ihzerrors.NewIllegalArgumentError("projection should not be nil", nil)
+	}
+	arg1Data, err = p.serializationService.ToData(proj)
+	return
+}
+
 func (p *proxy) validateAndSerializePredicate(pred predicate.Predicate) (arg1Data *iserialization.Data, err error) {
 	if check.Nil(pred) {
 		return nil, ihzerrors.NewIllegalArgumentError("predicate should not be nil", nil)
@@ -252,6 +281,16 @@ func (p *proxy) validateAndSerializeValues(values []interface{}) ([]*iserializat
 	return valuesData, nil
 }
 
+// validateAndSerializeTask serializes the given executor task, which must be IdentifiedDataSerializable or Portable.
+func (p *proxy) validateAndSerializeTask(task interface{}) (*iserialization.Data, error) {
+	switch task.(type) {
+	case serialization.IdentifiedDataSerializable, serialization.Portable:
+		return p.validateAndSerialize(task)
+	default:
+		return nil, ihzerrors.NewIllegalArgumentError("task must be IdentifiedDataSerializable or Portable", nil)
+	}
+}
+
 func (p *proxy) tryInvoke(ctx context.Context, f cb.TryHandler) (*proto.ClientMessage, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -302,6 +341,17 @@ func (p *proxy) invokeOnPartitionAsync(ctx context.Context, request *proto.Clien
 	return inv, err
 }
 
+func (p *proxy) invokeOnMember(ctx context.Context, request *proto.ClientMessage, member *pubcluster.MemberInfo) (*proto.ClientMessage, error) {
+	now := time.Now()
+	return p.tryInvoke(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+		inv := p.invocationFactory.NewMemberBoundInvocation(request, member, now)
+		if err := p.sendInvocation(ctx, inv); err != nil {
+			return nil, err
+		}
+		return inv.GetWithContext(ctx)
+	})
+}
+
 func (p *proxy) convertToObject(data *iserialization.Data) (interface{}, error) {
 	return p.serializationService.ToObject(data)
 }
@@ -322,6 +372,14 @@ func (p *proxy) convertToData(object interface{}) (*iserialization.Data, error)
 	return p.serializationService.ToData(object)
 }
 
+// convertToNullableData returns nil for a nil object, instead of the serialized form of nil.
+func (p *proxy) convertToNullableData(object interface{}) (*iserialization.Data, error) {
+	if check.Nil(object) {
+		return nil, nil
+	}
+	return p.serializationService.ToData(object)
+}
+
 func (p *proxy) partitionToPairs(keyValuePairs []types.Entry) (map[int32][]proto.Pair, error) {
 	ps := p.partitionService
 	partitionToPairs := map[int32][]proto.Pair{}
@@ -452,3 +510,11 @@ func extractLockID(ctx context.Context) int64 {
 	}
 	return int64(lid)
 }
+
+// randomPartitionID returns a random partition ID, or 0 if the partition count is not known yet.
+func randomPartitionID(partitionCount int32) int32 {
+	if partitionCount <= 0 {
+		return 0
+	}
+	return rand.Int31n(partitionCount)
+}
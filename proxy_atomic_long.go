@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+const (
+	atomicLongAlterOldValue = 0
+	atomicLongAlterNewValue = 1
+)
+
+/*
+AtomicLong is a redundant and highly available distributed counter backed by the CP Subsystem.
+
+AtomicLong is linearizable, unlike PNCounter which is eventually consistent.
+Use CPSubsystem.GetAtomicLong to create an AtomicLong instance.
+
+The functions passed to Apply and Alter methods are executed on the members, so they must be serializable
+and have a counterpart IFunction implementation on the member side.
+
+For details see https://docs.hazelcast.com/imdg/latest/data-structures/iatomiclong.html
+*/
+type AtomicLong struct {
+	*cpProxy
+}
+
+func newAtomicLong(p *cpProxy) *AtomicLong {
+	return &AtomicLong{cpProxy: p}
+}
+
+// AddAndGet atomically adds the given value to the current value and returns the updated value.
+func (a *AtomicLong) AddAndGet(ctx context.Context, delta int64) (int64, error) {
+	request := codec.EncodeAtomicLongAddAndGetRequest(a.groupID, a.name, delta)
+	if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeAtomicLongAddAndGetResponse(response), nil
+	}
+}
+
+// Alter alters the current value by applying the given function on it.
+func (a *AtomicLong) Alter(ctx context.Context, function interface{}) error {
+	_, err := a.alter(ctx, function, atomicLongAlterNewValue)
+	return err
+}
+
+// AlterAndGet alters the current value by applying the given function on it and returns the updated value.
+func (a *AtomicLong) AlterAndGet(ctx context.Context, function interface{}) (int64, error) {
+	return a.alter(ctx, function, atomicLongAlterNewValue)
+}
+
+// Apply applies the given function on the current value and returns the result.
+// The current value is not changed.
+func (a *AtomicLong) Apply(ctx context.Context, function interface{}) (interface{}, error) {
+	if functionData, err := a.validateAndSerialize(function); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeAtomicLongApplyRequest(a.groupID, a.name, functionData)
+		if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return a.convertToObject(codec.DecodeAtomicLongApplyResponse(response))
+		}
+	}
+}
+
+// CompareAndSet atomically sets the value to the given updated value only if the current value is equal to the expected value.
+// Returns true if the value was set.
+func (a *AtomicLong) CompareAndSet(ctx context.Context, expect int64, update int64) (bool, error) {
+	request := codec.EncodeAtomicLongCompareAndSetRequest(a.groupID, a.name, expect, update)
+	if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeAtomicLongCompareAndSetResponse(response), nil
+	}
+}
+
+// DecrementAndGet atomically decrements the current value by one and returns the updated value.
+func (a *AtomicLong) DecrementAndGet(ctx context.Context) (int64, error) {
+	return a.AddAndGet(ctx, -1)
+}
+
+// Get returns the current value.
+func (a *AtomicLong) Get(ctx context.Context) (int64, error) {
+	request := codec.EncodeAtomicLongGetRequest(a.groupID, a.name)
+	if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeAtomicLongGetResponse(response), nil
+	}
+}
+
+// GetAndAdd atomically adds the given value to the current value and returns the previous value.
+func (a *AtomicLong) GetAndAdd(ctx context.Context, delta int64) (int64, error) {
+	request := codec.EncodeAtomicLongGetAndAddRequest(a.groupID, a.name, delta)
+	if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeAtomicLongGetAndAddResponse(response), nil
+	}
+}
+
+// GetAndAlter alters the current value by applying the given function on it and returns the previous value.
+func (a *AtomicLong) GetAndAlter(ctx context.Context, function interface{}) (int64, error) {
+	return a.alter(ctx, function, atomicLongAlterOldValue)
+}
+
+// GetAndDecrement atomically decrements the current value by one and returns the previous value.
+func (a *AtomicLong) GetAndDecrement(ctx context.Context) (int64, error) {
+	return a.GetAndAdd(ctx, -1)
+}
+
+// GetAndIncrement atomically increments the current value by one and returns the previous value.
+func (a *AtomicLong) GetAndIncrement(ctx context.Context) (int64, error) {
+	return a.GetAndAdd(ctx, 1)
+}
+
+// GetAndSet atomically sets the given value and returns the previous value.
+func (a *AtomicLong) GetAndSet(ctx context.Context, value int64) (int64, error) {
+	request := codec.EncodeAtomicLongGetAndSetRequest(a.groupID, a.name, value)
+	if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeAtomicLongGetAndSetResponse(response), nil
+	}
+}
+
+// IncrementAndGet atomically increments the current value by one and returns the updated value.
+func (a *AtomicLong) IncrementAndGet(ctx context.Context) (int64, error) {
+	return a.AddAndGet(ctx, 1)
+}
+
+// Set atomically sets the given value.
+func (a *AtomicLong) Set(ctx context.Context, value int64) error {
+	_, err := a.GetAndSet(ctx, value)
+	return err
+}
+
+func (a *AtomicLong) alter(ctx context.Context, function interface{}, returnValueType int32) (int64, error) {
+	if functionData, err := a.validateAndSerialize(function); err != nil {
+		return 0, err
+	} else {
+		request := codec.EncodeAtomicLongAlterRequest(a.groupID, a.name, functionData, returnValueType)
+		if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+			return 0, err
+		} else {
+			return codec.DecodeAtomicLongAlterResponse(response), nil
+		}
+	}
+}
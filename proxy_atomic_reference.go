@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+const (
+	atomicReferenceReturnNoValue  = 0
+	atomicReferenceReturnOldValue = 1
+	atomicReferenceReturnNewValue = 2
+)
+
+/*
+AtomicReference is a redundant and highly available distributed reference backed by the CP Subsystem.
+
+The reference may be nil.
+Values are compared in their serialized form, so two values are equal if their serialized forms are the same.
+Use CPSubsystem.GetAtomicReference to create an AtomicReference instance.
+
+The functions passed to Apply and Alter methods are executed on the members, so they must be serializable
+and have a counterpart IFunction implementation on the member side.
+
+For details see https://docs.hazelcast.com/imdg/latest/data-structures/iatomicreference.html
+*/
+type AtomicReference struct {
+	*cpProxy
+}
+
+func newAtomicReference(p *cpProxy) *AtomicReference {
+	return &AtomicReference{cpProxy: p}
+}
+
+// Alter alters the current value by applying the given function on it.
+func (a *AtomicReference) Alter(ctx context.Context, function interface{}) error {
+	_, err := a.apply(ctx, function, atomicReferenceReturnNoValue, true)
+	return err
+}
+
+// AlterAndGet alters the current value by applying the given function on it and returns the updated value.
+func (a *AtomicReference) AlterAndGet(ctx context.Context, function interface{}) (interface{}, error) {
+	return a.apply(ctx, function, atomicReferenceReturnNewValue, true)
+}
+
+// Apply applies the given function on the current value and returns the result.
+// The current value is not changed.
+func (a *AtomicReference) Apply(ctx context.Context, function interface{}) (interface{}, error) {
+	return a.apply(ctx, function, atomicReferenceReturnNewValue, false)
+}
+
+// Clear sets the current value to nil.
+func (a *AtomicReference) Clear(ctx context.Context) error {
+	return a.Set(ctx, nil)
+}
+
+// CompareAndSet atomically sets the value to the given updated value only if the current value is equal to the expected value.
+// Returns true if the value was set.
+func (a *AtomicReference) CompareAndSet(ctx context.Context, expect interface{}, update interface{}) (bool, error) {
+	expectData, err := a.convertToNullableData(expect)
+	if err != nil {
+		return false, err
+	}
+	updateData, err := a.convertToNullableData(update)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeAtomicRefCompareAndSetRequest(a.groupID, a.name, expectData, updateData)
+	if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeAtomicRefCompareAndSetResponse(response), nil
+	}
+}
+
+// Contains returns true if the current value is equal to the given value.
+func (a *AtomicReference) Contains(ctx context.Context, value interface{}) (bool, error) {
+	if valueData, err := a.convertToNullableData(value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeAtomicRefContainsRequest(a.groupID, a.name, valueData)
+		if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeAtomicRefContainsResponse(response), nil
+		}
+	}
+}
+
+// Get returns the current value.
+func (a *AtomicReference) Get(ctx context.Context) (interface{}, error) {
+	request := codec.EncodeAtomicRefGetRequest(a.groupID, a.name)
+	if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+		return nil, err
+	} else {
+		return a.convertToObject(codec.DecodeAtomicRefGetResponse(response))
+	}
+}
+
+// GetAndAlter alters the current value by applying the given function on it and returns the previous value.
+func (a *AtomicReference) GetAndAlter(ctx context.Context, function interface{}) (interface{}, error) {
+	return a.apply(ctx, function, atomicReferenceReturnOldValue, true)
+}
+
+// GetAndSet atomically sets the given value and returns the previous value.
+func (a *AtomicReference) GetAndSet(ctx context.Context, value interface{}) (interface{}, error) {
+	return a.set(ctx, value, true)
+}
+
+// IsNil returns true if the current value is nil.
+func (a *AtomicReference) IsNil(ctx context.Context) (bool, error) {
+	return a.Contains(ctx, nil)
+}
+
+// Set atomically sets the given value.
+func (a *AtomicReference) Set(ctx context.Context, value interface{}) error {
+	_, err := a.set(ctx, value, false)
+	return err
+}
+
+func (a *AtomicReference) apply(ctx context.Context, function interface{}, returnValueType int32, alter bool) (interface{}, error) {
+	if functionData, err := a.validateAndSerialize(function); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeAtomicRefApplyRequest(a.groupID, a.name, functionData, returnValueType, alter)
+		if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return a.convertToObject(codec.DecodeAtomicRefApplyResponse(response))
+		}
+	}
+}
+
+func (a *AtomicReference) set(ctx context.Context, value interface{}, returnOldValue bool) (interface{}, error) {
+	if valueData, err := a.convertToNullableData(value); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeAtomicRefSetRequest(a.groupID, a.name, valueData, returnOldValue)
+		if response, err := a.invokeOnCPGroup(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return a.convertToObject(codec.DecodeAtomicRefSetResponse(response))
+		}
+	}
+}
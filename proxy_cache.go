@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	// cacheManagerPrefix is prepended to the cache names by the default cache manager of the Java clients and members.
+	cacheManagerPrefix = "/hz/"
+	// cacheNoCompletionID is sent when no completion event is requested.
+	cacheNoCompletionID int32 = -1
+)
+
+// CacheExpiryUnchanged can be used as a duration of a CacheExpiryPolicy to leave the expiry of the entry unchanged.
+const CacheExpiryUnchanged time.Duration = -1
+
+// CacheExpiryPolicy determines when the entries of a Cache expire.
+// It is the counterpart of javax.cache.expiry.ExpiryPolicy on the member side.
+type CacheExpiryPolicy struct {
+	// Creation is the duration until an entry expires after it is created.
+	Creation time.Duration
+	// Access is the duration until an entry expires after it is accessed.
+	Access time.Duration
+	// Update is the duration until an entry expires after it is updated.
+	Update time.Duration
+}
+
+/*
+Cache is a distributed cache compatible with JCache (JSR-107) caches on the member side.
+
+Cache is not created by the client, it must be configured on the cluster, or created by a Java client or member.
+Otherwise, Client.GetCache returns hzerrors.ErrCacheNotExists.
+
+The name of the cache is prefixed with the name prefix of the default cache manager, so a cache named "my-cache" in the Go client is the same cache as the one returned by the default cache manager of a Java client for "my-cache".
+
+For details see https://docs.hazelcast.com/imdg/latest/jcache/jcache.html
+*/
+type Cache struct {
+	*proxy
+	cacheName string
+}
+
+func newCache(p *proxy, cacheName string) *Cache {
+	return &Cache{proxy: p, cacheName: cacheName}
+}
+
+// AddEntryListener adds an entry listener to this cache.
+// The handler is called for the created, updated, removed and expired entries.
+func (c *Cache) AddEntryListener(ctx context.Context, handler CacheEntryNotifiedHandler) (types.UUID, error) {
+	subscriptionID := types.NewUUID()
+	addRequest := codec.EncodeCacheAddEntryListenerRequest(c.name, c.smart)
+	removeRequest := codec.EncodeCacheRemoveEntryListenerRequest(c.name, subscriptionID)
+	listenerHandler := func(msg *proto.ClientMessage) {
+		codec.HandleCacheAddEntryListener(msg, func(eventType int32, events []codec.CacheEventData, completionID int32) {
+			for _, event := range events {
+				c.handleEntryEvent(event, handler)
+			}
+		})
+	}
+	err := c.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler)
+	return subscriptionID, err
+}
+
+// Clear removes all entries in the cache, without notifying the listeners.
+func (c *Cache) Clear(ctx context.Context) error {
+	request := codec.EncodeCacheClearRequest(c.name)
+	_, err := c.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// ContainsKey returns true if the cache contains an entry for the given key.
+func (c *Cache) ContainsKey(ctx context.Context, key interface{}) (bool, error) {
+	keyData, err := c.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeCacheContainsKeyRequest(c.name, keyData)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCacheContainsKeyResponse(response), nil
+}
+
+// Get returns the value for the given key, or nil if the cache does not contain the key.
+func (c *Cache) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := c.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeCacheGetRequest(c.name, keyData, nil)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertToObject(codec.DecodeCacheGetResponse(response))
+}
+
+// GetAll returns the entries for the given keys.
+// The keys which are not in the cache are not included in the result.
+func (c *Cache) GetAll(ctx context.Context, keys ...interface{}) ([]types.Entry, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	keyDatas, err := c.validateAndSerializeValues(keys)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeCacheGetAllRequest(c.name, keyDatas, nil)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertPairsToEntries(codec.DecodeCacheGetAllResponse(response))
+}
+
+// GetAndPut sets the value for the given key and returns the old value.
+func (c *Cache) GetAndPut(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	response, err := c.put(ctx, key, value, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	return c.convertToObject(codec.DecodeCachePutResponse(response))
+}
+
+// Put sets the value for the given key.
+func (c *Cache) Put(ctx context.Context, key interface{}, value interface{}) error {
+	_, err := c.put(ctx, key, value, nil, false)
+	return err
+}
+
+// PutIfAbsent sets the value for the given key if the cache does not contain the key.
+// Returns true if the value was set.
+func (c *Cache) PutIfAbsent(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	keyData, valueData, err := c.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeCachePutIfAbsentRequest(c.name, keyData, valueData, nil, cacheNoCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCachePutIfAbsentResponse(response), nil
+}
+
+// PutWithExpiryPolicy sets the value for the given key using the given expiry policy instead of the one configured for the cache.
+func (c *Cache) PutWithExpiryPolicy(ctx context.Context, key interface{}, value interface{}, policy CacheExpiryPolicy) error {
+	policyData, err := cacheExpiryPolicyData(c.serializationService, policy)
+	if err != nil {
+		return err
+	}
+	_, err = c.put(ctx, key, value, policyData, false)
+	return err
+}
+
+// Remove removes the entry with the given key.
+// Returns true if the cache contained the key.
+func (c *Cache) Remove(ctx context.Context, key interface{}) (bool, error) {
+	keyData, err := c.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeCacheRemoveRequest(c.name, keyData, nil, cacheNoCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	return codec.DecodeCacheRemoveResponse(response), nil
+}
+
+// RemoveEntryListener removes the specified entry listener.
+func (c *Cache) RemoveEntryListener(ctx context.Context, subscriptionID types.UUID) error {
+	return c.listenerBinder.Remove(ctx, subscriptionID)
+}
+
+// Replace sets the value for the given key only if the cache contains the key.
+// Returns true if the value was replaced.
+func (c *Cache) Replace(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	keyData, valueData, err := c.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeCacheReplaceRequest(c.name, keyData, nil, valueData, nil, cacheNoCompletionID)
+	response, err := c.invokeOnKey(ctx, request, keyData)
+	if err != nil {
+		return false, err
+	}
+	// the response is the serialized form of the boolean result
+	replaced, err := c.convertToObject(codec.DecodeCacheReplaceResponse(response))
+	if err != nil {
+		return false, err
+	}
+	ok, _ := replaced.(bool)
+	return ok, nil
+}
+
+// Size returns the number of entries in the cache.
+func (c *Cache) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeCacheSizeRequest(c.name)
+	response, err := c.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return 0, err
+	}
+	return int(codec.DecodeCacheSizeResponse(response)), nil
+}
+
+func (c *Cache) handleEntryEvent(event codec.CacheEventData, handler CacheEntryNotifiedHandler) {
+	key, err := c.convertToObject(event.DataKey)
+	if err != nil {
+		c.logger.Warnf("cannot convert key data to Go value: %v", err)
+		return
+	}
+	value, err := c.convertToObject(event.DataValue)
+	if err != nil {
+		c.logger.Warnf("cannot convert value data to Go value: %v", err)
+		return
+	}
+	var oldValue interface{}
+	if event.OldValueAvailable {
+		if oldValue, err = c.convertToObject(event.DataOldValue); err != nil {
+			c.logger.Warnf("cannot convert old value data to Go value: %v", err)
+			return
+		}
+	}
+	handler(newCacheEntryNotified(c.cacheName, key, value, oldValue, CacheEntryEventType(event.CacheEventType)))
+}
+
+func (c *Cache) put(ctx context.Context, key interface{}, value interface{}, policyData *iserialization.Data, get bool) (*proto.ClientMessage, error) {
+	keyData, valueData, err := c.validateAndSerialize2(key, value)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeCachePutRequest(c.name, keyData, valueData, policyData, get, cacheNoCompletionID)
+	return c.invokeOnKey(ctx, request, keyData)
+}
+
+// cacheExpiryPolicyData serializes the policy in the form of the member side HazelcastExpiryPolicy.
+// Only the pointer implements IdentifiedDataSerializable, a value would be serialized with the fallback serializer.
+func cacheExpiryPolicyData(ss *iserialization.Service, policy CacheExpiryPolicy) (*iserialization.Data, error) {
+	p := iproxy.CacheExpiryPolicy(policy)
+	return ss.ToData(&p)
+}
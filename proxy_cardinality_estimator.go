@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+CardinalityEstimator is a data structure which estimates the cardinality of a set of items.
+
+The estimation is based on the HyperLogLog algorithm, so it uses a fixed amount of memory regardless of the number of added items.
+The hashes of the items are computed by the client, so only the 64-bit hash of an item is sent to the cluster.
+The hash of an item is the same as the one computed by the other Hazelcast clients for the same serialized item.
+
+All of the data of the CardinalityEstimator is stored in a single partition (and in the backups).
+
+For details see https://docs.hazelcast.com/imdg/latest/data-structures/cardinality-estimator-service.html
+*/
+type CardinalityEstimator struct {
+	*proxy
+	partitionID int32
+}
+
+func newCardinalityEstimator(p *proxy) (*CardinalityEstimator, error) {
+	partitionID, err := p.stringToPartitionID(p.name)
+	if err != nil {
+		return nil, err
+	}
+	return &CardinalityEstimator{proxy: p, partitionID: partitionID}, nil
+}
+
+// Add adds the given item to the estimation.
+func (c *CardinalityEstimator) Add(ctx context.Context, item interface{}) error {
+	itemData, err := c.validateAndSerialize(item)
+	if err != nil {
+		return err
+	}
+	request := codec.EncodeCardinalityEstimatorAddRequest(c.name, itemData.Hash64())
+	_, err = c.invokeOnPartition(ctx, request, c.partitionID)
+	return err
+}
+
+// Estimate returns the estimated cardinality of the added items.
+func (c *CardinalityEstimator) Estimate(ctx context.Context) (int64, error) {
+	request := codec.EncodeCardinalityEstimatorEstimateRequest(c.name)
+	response, err := c.invokeOnPartition(ctx, request, c.partitionID)
+	if err != nil {
+		return 0, err
+	}
+	return codec.DecodeCardinalityEstimatorEstimateResponse(response), nil
+}
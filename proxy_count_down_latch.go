@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+CountDownLatch is a linearizable, distributed synchronization aid backed by the CP Subsystem.
+
+A CountDownLatch is initialized with a count using TrySetCount.
+Await blocks until the count reaches zero, and CountDown decrements the count.
+Once the count reaches zero, the latch can be reused by setting a new count.
+
+For details see https://docs.hazelcast.com/imdg/latest/cp-subsystem/icountdownlatch.html
+*/
+type CountDownLatch struct {
+	*cpProxy
+}
+
+func newCountDownLatch(p *cpProxy) *CountDownLatch {
+	return &CountDownLatch{cpProxy: p}
+}
+
+// Await blocks until the count reaches zero, the given timeout elapses or the context is done.
+// Returns true if the count reached zero, false if the timeout elapsed.
+func (c *CountDownLatch) Await(ctx context.Context, timeout time.Duration) (bool, error) {
+	if timeout < 0 {
+		timeout = 0
+	}
+	request := codec.EncodeCountDownLatchAwaitRequest(c.groupID, c.name, types.NewUUID(), timeout.Milliseconds())
+	if response, err := c.invokeOnCPGroup(ctx, request); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeCountDownLatchAwaitResponse(response), nil
+	}
+}
+
+// CountDown decrements the count, releasing the waiting callers if the count reaches zero.
+// If the count is already zero, nothing happens.
+func (c *CountDownLatch) CountDown(ctx context.Context) error {
+	request := codec.EncodeCountDownLatchGetRoundRequest(c.groupID, c.name)
+	response, err := c.invokeOnCPGroup(ctx, request)
+	if err != nil {
+		return err
+	}
+	round := codec.DecodeCountDownLatchGetRoundResponse(response)
+	// the same invocation UID is used for the retries, so the count is decremented at most once
+	invocationUID := types.NewUUID()
+	for {
+		request = codec.EncodeCountDownLatchCountDownRequest(c.groupID, c.name, invocationUID, round)
+		if _, err = c.invokeOnCPGroup(ctx, request); err == nil || !errors.Is(err, hzerrors.ErrOperationTimeout) {
+			return err
+		}
+		if ctx != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// GetCount returns the current count.
+func (c *CountDownLatch) GetCount(ctx context.Context) (int, error) {
+	request := codec.EncodeCountDownLatchGetCountRequest(c.groupID, c.name)
+	if response, err := c.invokeOnCPGroup(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return int(codec.DecodeCountDownLatchGetCountResponse(response)), nil
+	}
+}
+
+// TrySetCount sets the count to the given value if the current count is zero.
+// Returns true if the count is set.
+func (c *CountDownLatch) TrySetCount(ctx context.Context, count int) (bool, error) {
+	if count <= 0 {
+		return false, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("count must be positive: %d", count), nil)
+	}
+	n, err := check.NonNegativeInt32(count)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeCountDownLatchTrySetCountRequest(c.groupID, c.name, n)
+	if response, err := c.invokeOnCPGroup(ctx, request); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeCountDownLatchTrySetCountResponse(response), nil
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+// cpProxy is the base of the CP data structure proxies.
+// The requests of a CP proxy are sent to a member, which forwards them to the leader of the CP group.
+type cpProxy struct {
+	*proxy
+	targets   *cpGroupTargets
+	proxyName string
+	groupID   codec.RaftGroupId
+}
+
+// Destroy removes this object from its CP group.
+// Once destroyed, the object cannot be used again; using it, or creating a new proxy with the same name fails.
+func (p *cpProxy) Destroy(ctx context.Context) error {
+	request := codec.EncodeCPGroupDestroyCPObjectRequest(p.groupID, p.serviceName, p.name)
+	if _, err := p.invokeOnCPGroup(ctx, request); err != nil {
+		return fmt.Errorf("error destroying proxy: %w", err)
+	}
+	return nil
+}
+
+// invokeOnCPGroup sends the request to the CP group of this proxy.
+func (p *cpProxy) invokeOnCPGroup(ctx context.Context, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	return p.invokeOnGroup(ctx, p.groupID, request)
+}
+
+// invokeOnGroup sends the request to the member which served the previous requests to the given CP group.
+// The member forwards the request to the leader of the group.
+// If the request to the member fails, it is retried on another member.
+func (p *cpProxy) invokeOnGroup(ctx context.Context, groupID codec.RaftGroupId, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	now := time.Now()
+	return p.tryInvoke(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+		if attempt > 0 {
+			request = request.Copy()
+		}
+		target := p.groupTarget(ctx, groupID)
+		inv := p.invocationFactory.NewInvocationOnTarget(request, target, now)
+		if err := p.sendInvocation(ctx, inv); err != nil {
+			p.targets.remove(groupID, target)
+			return nil, err
+		}
+		response, err := inv.GetWithContext(ctx)
+		if err != nil {
+			var nonRetryableErr *cb.NonRetryableError
+			if errors.As(err, &nonRetryableErr) && isCPNotLeaderError(nonRetryableErr.Err) {
+				// the request was not executed, so it is safe to retry it on another member
+				p.targets.remove(groupID, target)
+				return nil, nonRetryableErr.Err
+			}
+			if isCPNotLeaderError(err) || isCPTargetLostError(err) {
+				p.targets.remove(groupID, target)
+			}
+			return nil, err
+		}
+		if target != "" {
+			p.targets.set(groupID, target)
+		}
+		return response, nil
+	})
+}
+
+// groupTarget returns the address of the member which served the previous requests to the given CP group, if there is one.
+// Otherwise, returns the address of a random member.
+func (p *cpProxy) groupTarget(ctx context.Context, groupID codec.RaftGroupId) pubcluster.Address {
+	if addr, ok := p.targets.get(groupID); ok {
+		return addr
+	}
+	members := p.clusterService.OrderedMembers()
+	if len(members) == 0 {
+		return ""
+	}
+	member := members[rand.Intn(len(members))]
+	addr, err := p.clusterService.TranslateMember(ctx, &member)
+	if err != nil {
+		return ""
+	}
+	return addr
+}
+
+// isCPNotLeaderError returns true if the request was sent to a member which does not lead the CP group.
+func isCPNotLeaderError(err error) bool {
+	return errors.Is(err, hzerrors.ErrNotLeaderException) || errors.Is(err, hzerrors.ErrLeaderDemotedException)
+}
+
+// isCPTargetLostError returns true if the member which the request was sent to is not reachable anymore.
+func isCPTargetLostError(err error) bool {
+	return errors.Is(err, hzerrors.ErrTargetDisconnected) ||
+		errors.Is(err, hzerrors.ErrTargetNotMember) ||
+		errors.Is(err, hzerrors.ErrMemberLeft) ||
+		errors.Is(err, hzerrors.ErrIO)
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+CPMap is a linearizable key-value store backed by the CP Subsystem.
+
+Unlike Map, which favors availability, CPMap stays consistent during network partitions.
+All entries of a CPMap are stored in a single CP group, so it is suitable for small data sets, such as configuration flags.
+Keys and values are serialized with the serialization service of the client and cannot be nil.
+
+For details see https://docs.hazelcast.com/hazelcast/latest/data-structures/cpmap
+*/
+type CPMap struct {
+	*cpProxy
+}
+
+func newCPMap(p *cpProxy) *CPMap {
+	return &CPMap{cpProxy: p}
+}
+
+// CompareAndSet sets the value of the given key to newValue if its current value is equal to expectedValue.
+// Returns true if the value is set.
+func (m *CPMap) CompareAndSet(ctx context.Context, key interface{}, expectedValue interface{}, newValue interface{}) (bool, error) {
+	if keyData, expectedData, newData, err := m.validateAndSerialize3(key, expectedValue, newValue); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeCPMapCompareAndSetRequest(m.groupID, m.name, keyData, expectedData, newData)
+		if response, err := m.invokeOnCPGroup(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeCPMapCompareAndSetResponse(response), nil
+		}
+	}
+}
+
+// Delete removes the given key.
+func (m *CPMap) Delete(ctx context.Context, key interface{}) error {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return err
+	} else {
+		request := codec.EncodeCPMapDeleteRequest(m.groupID, m.name, keyData)
+		_, err := m.invokeOnCPGroup(ctx, request)
+		return err
+	}
+}
+
+// Get returns the value of the given key, or nil if the key does not exist.
+func (m *CPMap) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeCPMapGetRequest(m.groupID, m.name, keyData)
+		if response, err := m.invokeOnCPGroup(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeCPMapGetResponse(response))
+		}
+	}
+}
+
+// Put sets the value of the given key and returns the old value, or nil if the key did not exist.
+func (m *CPMap) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeCPMapPutRequest(m.groupID, m.name, keyData, valueData)
+		if response, err := m.invokeOnCPGroup(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeCPMapPutResponse(response))
+		}
+	}
+}
+
+// Remove removes the given key and returns its value, or nil if the key did not exist.
+func (m *CPMap) Remove(ctx context.Context, key interface{}) (interface{}, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeCPMapRemoveRequest(m.groupID, m.name, keyData)
+		if response, err := m.invokeOnCPGroup(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeCPMapRemoveResponse(response))
+		}
+	}
+}
+
+// Set sets the value of the given key.
+// Set is more efficient than Put, since the old value is not returned.
+func (m *CPMap) Set(ctx context.Context, key interface{}, value interface{}) error {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return err
+	} else {
+		request := codec.EncodeCPMapSetRequest(m.groupID, m.name, keyData, valueData)
+		_, err := m.invokeOnCPGroup(ctx, request)
+		return err
+	}
+}
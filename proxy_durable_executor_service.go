@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+/*
+DurableExecutorService executes tasks on the members of the cluster and keeps their results until they are retrieved.
+
+Tasks are stored in the partition which they are submitted to, and they are replicated to the backups of that partition,
+so they are executed even if the member that runs them crashes.
+Submitting a task returns a DurableExecutorFuture, whose task ID can be used to retrieve the result later,
+even from another client.
+
+Tasks must be serializable, so they must be IdentifiedDataSerializable or Portable
+and have a counterpart Callable implementation on the member side.
+
+For details see https://docs.hazelcast.com/imdg/latest/computing/durable-executor-service.html
+*/
+type DurableExecutorService struct {
+	*proxy
+}
+
+func newDurableExecutorService(p *proxy) *DurableExecutorService {
+	return &DurableExecutorService{proxy: p}
+}
+
+// DisposeResult removes the result of the task with the given ID.
+func (d *DurableExecutorService) DisposeResult(ctx context.Context, taskID int64) error {
+	partitionID, sequence := durableTaskIDParts(taskID)
+	request := codec.EncodeDurableExecutorDisposeResultRequest(d.name, sequence)
+	_, err := d.invokeOnPartition(ctx, request, partitionID)
+	return err
+}
+
+// IsShutdown returns true if the executor is shut down.
+func (d *DurableExecutorService) IsShutdown(ctx context.Context) (bool, error) {
+	request := codec.EncodeDurableExecutorIsShutdownRequest(d.name)
+	if response, err := d.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeDurableExecutorIsShutdownResponse(response), nil
+	}
+}
+
+// RetrieveAndDisposeResult blocks until the task with the given ID completes, returns its result and removes the result.
+func (d *DurableExecutorService) RetrieveAndDisposeResult(ctx context.Context, taskID int64) (interface{}, error) {
+	partitionID, sequence := durableTaskIDParts(taskID)
+	request := codec.EncodeDurableExecutorRetrieveAndDisposeResultRequest(d.name, sequence)
+	if response, err := d.invokeOnPartition(ctx, request, partitionID); err != nil {
+		return nil, err
+	} else {
+		return d.convertToObject(codec.DecodeDurableExecutorRetrieveAndDisposeResultResponse(response))
+	}
+}
+
+// RetrieveResult blocks until the task with the given ID completes and returns its result.
+func (d *DurableExecutorService) RetrieveResult(ctx context.Context, taskID int64) (interface{}, error) {
+	partitionID, sequence := durableTaskIDParts(taskID)
+	request := codec.EncodeDurableExecutorRetrieveResultRequest(d.name, sequence)
+	if response, err := d.invokeOnPartition(ctx, request, partitionID); err != nil {
+		return nil, err
+	} else {
+		return d.convertToObject(codec.DecodeDurableExecutorRetrieveResultResponse(response))
+	}
+}
+
+// Shutdown shuts down the executor.
+// The previously submitted tasks are executed, but new tasks are rejected.
+func (d *DurableExecutorService) Shutdown(ctx context.Context) error {
+	request := codec.EncodeDurableExecutorShutdownRequest(d.name)
+	_, err := d.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// Submit submits the task to a random partition.
+func (d *DurableExecutorService) Submit(ctx context.Context, task interface{}) (*DurableExecutorFuture, error) {
+	taskData, err := d.validateAndSerializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	return d.submitToPartition(ctx, taskData, randomPartitionID(d.partitionService.PartitionCount()))
+}
+
+// SubmitToKeyOwner submits the task to the partition of the given key.
+func (d *DurableExecutorService) SubmitToKeyOwner(ctx context.Context, task interface{}, key interface{}) (*DurableExecutorFuture, error) {
+	taskData, err := d.validateAndSerializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	keyData, err := d.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := d.partitionService.GetPartitionID(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return d.submitToPartition(ctx, taskData, partitionID)
+}
+
+func (d *DurableExecutorService) submitToPartition(ctx context.Context, taskData *iserialization.Data, partitionID int32) (*DurableExecutorFuture, error) {
+	request := codec.EncodeDurableExecutorSubmitToPartitionRequest(d.name, taskData)
+	response, err := d.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return nil, err
+	}
+	sequence := codec.DecodeDurableExecutorSubmitToPartitionResponse(response)
+	return &DurableExecutorFuture{
+		executor: d,
+		taskID:   durableTaskID(partitionID, sequence),
+	}, nil
+}
+
+// DurableExecutorFuture is the pending result of a task submitted to a DurableExecutorService.
+type DurableExecutorFuture struct {
+	executor *DurableExecutorService
+	taskID   int64
+}
+
+// Get blocks until the task completes or the context is done and returns the result of the task.
+func (f *DurableExecutorFuture) Get(ctx context.Context) (interface{}, error) {
+	return f.executor.RetrieveResult(ctx, f.taskID)
+}
+
+// TaskID returns the ID of the task.
+// The ID can be used to retrieve the result of the task with DurableExecutorService.RetrieveResult.
+func (f *DurableExecutorFuture) TaskID() int64 {
+	return f.taskID
+}
+
+// durableTaskID combines the partition ID and the sequence of a task to a task ID.
+func durableTaskID(partitionID int32, sequence int32) int64 {
+	return int64(partitionID)<<32 | int64(uint32(sequence))
+}
+
+// durableTaskIDParts splits the given task ID to the partition ID and the sequence of the task.
+func durableTaskIDParts(taskID int64) (partitionID int32, sequence int32) {
+	return int32(taskID >> 32), int32(taskID)
+}
@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/invocation"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+ExecutorService executes tasks on the members of the cluster.
+
+A task is a callable, which is executed on the member side and returns a result.
+Tasks must be serializable, so they must be IdentifiedDataSerializable or Portable
+and have a counterpart Callable implementation on the member side.
+
+Submitting a task returns an ExecutorFuture, which can be used to wait for the result of the task or cancel it.
+
+	executor, err := client.GetExecutorService(ctx, "my-executor")
+	future, err := executor.Submit(ctx, &MyTask{})
+	result, err := future.Get(ctx)
+
+For details see https://docs.hazelcast.com/imdg/latest/computing/executor-service.html
+*/
+type ExecutorService struct {
+	*proxy
+}
+
+func newExecutorService(p *proxy) *ExecutorService {
+	return &ExecutorService{proxy: p}
+}
+
+// IsShutdown returns true if the executor is shut down.
+func (e *ExecutorService) IsShutdown(ctx context.Context) (bool, error) {
+	request := codec.EncodeExecutorServiceIsShutdownRequest(e.name)
+	if response, err := e.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeExecutorServiceIsShutdownResponse(response), nil
+	}
+}
+
+// Shutdown shuts down the executor.
+// The previously submitted tasks are executed, but new tasks are rejected.
+func (e *ExecutorService) Shutdown(ctx context.Context) error {
+	request := codec.EncodeExecutorServiceShutdownRequest(e.name)
+	_, err := e.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// Submit submits the task to a random data member.
+// Lite members are not selected, since they do not run the tasks of the executor.
+func (e *ExecutorService) Submit(ctx context.Context, task interface{}) (*ExecutorFuture, error) {
+	var members []cluster.MemberInfo
+	for _, member := range e.clusterService.OrderedMembers() {
+		if !member.LiteMember {
+			members = append(members, member)
+		}
+	}
+	if len(members) == 0 {
+		return nil, ihzerrors.NewClientError("no data member found to submit the task", nil, hzerrors.ErrRejectedExecution)
+	}
+	taskData, err := e.validateAndSerializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	member := members[rand.Intn(len(members))]
+	return e.submitToMember(ctx, taskData, &member)
+}
+
+// SubmitToAllMembers submits the task to all members.
+func (e *ExecutorService) SubmitToAllMembers(ctx context.Context, task interface{}) ([]*ExecutorFuture, error) {
+	return e.submitToMembers(ctx, task, e.clusterService.OrderedMembers())
+}
+
+// SubmitToKeyOwner submits the task to the owner of the partition of the given key.
+func (e *ExecutorService) SubmitToKeyOwner(ctx context.Context, task interface{}, key interface{}) (*ExecutorFuture, error) {
+	taskData, keyData, err := e.validateTaskAndKey(task, key)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := e.partitionService.GetPartitionID(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return e.submitToPartition(ctx, taskData, partitionID)
+}
+
+// SubmitToMember submits the task to the member with the given UUID.
+func (e *ExecutorService) SubmitToMember(ctx context.Context, task interface{}, memberUUID types.UUID) (*ExecutorFuture, error) {
+	member := e.clusterService.GetMemberByUUID(memberUUID)
+	if member == nil {
+		return nil, ihzerrors.NewClientError(fmt.Sprintf("member %s not found", memberUUID), nil, hzerrors.ErrTargetNotMember)
+	}
+	taskData, err := e.validateAndSerializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	return e.submitToMember(ctx, taskData, member)
+}
+
+// SubmitToMembers submits the task to the members selected by the given selector.
+func (e *ExecutorService) SubmitToMembers(ctx context.Context, task interface{}, selector cluster.MemberSelector) ([]*ExecutorFuture, error) {
+	if selector == nil {
+		return nil, ihzerrors.NewIllegalArgumentError("member selector cannot be nil", nil)
+	}
+	var members []cluster.MemberInfo
+	for _, member := range e.clusterService.OrderedMembers() {
+		if selector.Select(member) {
+			members = append(members, member)
+		}
+	}
+	return e.submitToMembers(ctx, task, members)
+}
+
+// submitToMembers sends the task to the given members.
+// If the task cannot be sent to one of the members, the error is returned, but the tasks already sent are not cancelled.
+func (e *ExecutorService) submitToMembers(ctx context.Context, task interface{}, members []cluster.MemberInfo) ([]*ExecutorFuture, error) {
+	if len(members) == 0 {
+		return nil, ihzerrors.NewClientError("no member selected to submit the task", nil, hzerrors.ErrRejectedExecution)
+	}
+	taskData, err := e.validateAndSerializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	futures := make([]*ExecutorFuture, len(members))
+	for i := range members {
+		if futures[i], err = e.submitToMember(ctx, taskData, &members[i]); err != nil {
+			return nil, err
+		}
+	}
+	return futures, nil
+}
+
+func (e *ExecutorService) submitToMember(ctx context.Context, taskData *iserialization.Data, member *cluster.MemberInfo) (*ExecutorFuture, error) {
+	uuid := types.NewUUID()
+	request := codec.EncodeExecutorServiceSubmitToMemberRequest(e.name, uuid, taskData, member.UUID)
+	inv := e.invocationFactory.NewMemberBoundInvocation(request, member, time.Now())
+	if err := e.sendInvocation(ctx, inv); err != nil {
+		return nil, err
+	}
+	return newExecutorFuture(e, uuid, -1, member, inv, codec.DecodeExecutorServiceSubmitToMemberResponse), nil
+}
+
+func (e *ExecutorService) submitToPartition(ctx context.Context, taskData *iserialization.Data, partitionID int32) (*ExecutorFuture, error) {
+	uuid := types.NewUUID()
+	request := codec.EncodeExecutorServiceSubmitToPartitionRequest(e.name, uuid, taskData)
+	inv, err := e.invokeOnPartitionAsync(ctx, request, partitionID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return newExecutorFuture(e, uuid, partitionID, nil, inv, codec.DecodeExecutorServiceSubmitToPartitionResponse), nil
+}
+
+func (e *ExecutorService) validateTaskAndKey(task interface{}, key interface{}) (*iserialization.Data, *iserialization.Data, error) {
+	taskData, err := e.validateAndSerializeTask(task)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyData, err := e.validateAndSerialize(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return taskData, keyData, nil
+}
+
+// ExecutorFuture is the pending result of a task submitted to an ExecutorService.
+// In addition to waiting for the result, it can be used to cancel the task.
+type ExecutorFuture struct {
+	*Future
+	executor    *ExecutorService
+	member      *cluster.MemberInfo
+	cancel      context.CancelFunc
+	uuid        types.UUID
+	partitionID int32
+	cancelled   int32
+}
+
+// newExecutorFuture creates a future which waits for the response of the sent task and completes with its result.
+// Waiting is independent of the context used to submit the task, it stops when the task is cancelled.
+func newExecutorFuture(e *ExecutorService, uuid types.UUID, partitionID int32, member *cluster.MemberInfo, inv invocation.Invocation, decode func(*proto.ClientMessage) *iserialization.Data) *ExecutorFuture {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &ExecutorFuture{
+		executor:    e,
+		member:      member,
+		cancel:      cancel,
+		uuid:        uuid,
+		partitionID: partitionID,
+	}
+	f.Future = newFuture(func() (interface{}, error) {
+		defer cancel()
+		response, err := inv.GetWithContext(ctx)
+		if f.IsCancelled() {
+			return nil, ihzerrors.NewClientError("task is cancelled", nil, hzerrors.ErrCancellation)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return e.convertToObject(decode(response))
+	})
+	return f
+}
+
+// Cancel attempts to cancel the task.
+// If interrupt is true, the task is interrupted if it is already running.
+// Returns true if the task is cancelled, in which case Get returns hzerrors.ErrCancellation.
+func (f *ExecutorFuture) Cancel(ctx context.Context, interrupt bool) (bool, error) {
+	select {
+	case <-f.Done():
+		return false, nil
+	default:
+	}
+	var cancelled bool
+	if f.member == nil {
+		request := codec.EncodeExecutorServiceCancelOnPartitionRequest(f.uuid, interrupt)
+		response, err := f.executor.invokeOnPartition(ctx, request, f.partitionID)
+		if err != nil {
+			return false, err
+		}
+		cancelled = codec.DecodeExecutorServiceCancelOnPartitionResponse(response)
+	} else {
+		request := codec.EncodeExecutorServiceCancelOnMemberRequest(f.uuid, f.member.UUID, interrupt)
+		response, err := f.executor.invokeOnMember(ctx, request, f.member)
+		if err != nil {
+			return false, err
+		}
+		cancelled = codec.DecodeExecutorServiceCancelOnMemberResponse(response)
+	}
+	if cancelled {
+		atomic.StoreInt32(&f.cancelled, 1)
+		// stop waiting for the result of the task
+		f.cancel()
+	}
+	return cancelled, nil
+}
+
+// IsCancelled returns true if the task was cancelled.
+func (f *ExecutorFuture) IsCancelled() bool {
+	return atomic.LoadInt32(&f.cancelled) == 1
+}
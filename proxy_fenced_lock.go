@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// InvalidFence is the fencing token returned when a FencedLock could not be acquired.
+const InvalidFence int64 = 0
+
+/*
+FencedLock is a linearizable, distributed and reentrant lock backed by the CP Subsystem.
+
+Unlike the locks of Map, a FencedLock returns a fencing token for each acquire, which is a monotonically increasing number.
+Fencing tokens can be passed to external services to order the operations of the lock holders
+and reject the operations of the stale holders.
+
+A FencedLock is held within a CP session.
+The client keeps its sessions alive with periodic heartbeats, so if the client dies, its sessions expire and the locks held by it are released.
+
+Similar to Map locks, lock ownership is explicit.
+A lock context created with NewLockContext identifies the lock holder.
+The same lock context can acquire the lock again without waiting for it to be unlocked.
+If the lock is acquired N times, it should be unlocked N times before another lock context can acquire it.
+
+	lock, err := client.CPSubsystem().GetFencedLock(ctx, "my-lock")
+	lockCtx := lock.NewLockContext(ctx)
+	// block acquiring the lock
+	fence, err := lock.Lock(lockCtx)
+	// pass the fencing token to the external service
+	// ...
+	err = lock.Unlock(lockCtx)
+
+For details see https://docs.hazelcast.com/imdg/latest/cp-subsystem/fencedlock.html
+*/
+type FencedLock struct {
+	*cpProxy
+	sessions *cpSessionManager
+	mu       *sync.Mutex
+	// lockedSessionIDs keeps the IDs of the sessions which hold the lock, keyed by lock ID.
+	lockedSessionIDs map[int64]int64
+}
+
+func newFencedLock(p *cpProxy, sessions *cpSessionManager) *FencedLock {
+	return &FencedLock{
+		cpProxy:          p,
+		sessions:         sessions,
+		mu:               &sync.Mutex{},
+		lockedSessionIDs: map[int64]int64{},
+	}
+}
+
+// NewLockContext augments the passed parent context with a unique lock ID.
+// If passed context is nil, context.Background is used as the parent context.
+func (l *FencedLock) NewLockContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, lockIDKey, lockID(l.refIDGen.NextID()))
+}
+
+// IsLocked returns true if the lock is held by any lock context.
+func (l *FencedLock) IsLocked(ctx context.Context) (bool, error) {
+	request := codec.EncodeFencedLockGetLockOwnershipRequest(l.groupID, l.name)
+	if response, err := l.invokeOnCPGroup(ctx, request); err != nil {
+		return false, err
+	} else {
+		fence, _, _, _ := codec.DecodeFencedLockGetLockOwnershipResponse(response)
+		return fence != InvalidFence, nil
+	}
+}
+
+// Lock acquires the lock and returns the fencing token.
+// If the lock is held by another lock context, blocks until the lock is released or the context is done.
+// Returns hzerrors.ErrLockOwnershipLostException if the session of the caller expired while it was holding the lock.
+func (l *FencedLock) Lock(ctx context.Context) (int64, error) {
+	lid := extractLockID(ctx)
+	invocationUID := types.NewUUID()
+	for {
+		sessionID, err := l.sessions.acquireSession(ctx, l.groupID, 1)
+		if err != nil {
+			return InvalidFence, err
+		}
+		if err := l.verifyLockedSessionID(lid, sessionID, true); err != nil {
+			return InvalidFence, err
+		}
+		request := codec.EncodeFencedLockLockRequest(l.groupID, l.name, sessionID, lid, invocationUID)
+		response, err := l.invokeOnCPGroup(ctx, request)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				l.sessions.invalidateSession(l.groupID, sessionID)
+				if err := l.verifyNoLockedSessionID(lid); err != nil {
+					return InvalidFence, err
+				}
+				continue
+			}
+			l.sessions.releaseSession(l.groupID, sessionID, 1)
+			if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+				return InvalidFence, ihzerrors.NewClientError("lock wait is cancelled", err, hzerrors.ErrIllegalMonitorState)
+			}
+			return InvalidFence, err
+		}
+		fence := codec.DecodeFencedLockLockResponse(response)
+		if fence == InvalidFence {
+			l.sessions.releaseSession(l.groupID, sessionID, 1)
+			msg := fmt.Sprintf("lock reentrant acquire limit is already reached: %s", l.proxyName)
+			return InvalidFence, ihzerrors.NewClientError(msg, nil, hzerrors.ErrLockAcquireLimitReachedException)
+		}
+		l.setLockedSessionID(lid, sessionID)
+		return fence, nil
+	}
+}
+
+// TryLock acquires the lock only if it is free or already held by the lock context at the time of the call and returns the fencing token.
+// Returns InvalidFence if the lock was not acquired.
+func (l *FencedLock) TryLock(ctx context.Context) (int64, error) {
+	return l.tryLock(ctx, 0)
+}
+
+// TryLockWithTimeout acquires the lock if it is free or already held by the lock context within the given timeout and returns the fencing token.
+// Returns InvalidFence if the lock was not acquired.
+func (l *FencedLock) TryLockWithTimeout(ctx context.Context, timeout time.Duration) (int64, error) {
+	return l.tryLock(ctx, timeout)
+}
+
+// Unlock releases the lock once.
+// Returns hzerrors.ErrIllegalMonitorState if the lock is not held by the lock context,
+// and hzerrors.ErrLockOwnershipLostException if the session of the caller expired while it was holding the lock.
+func (l *FencedLock) Unlock(ctx context.Context) error {
+	lid := extractLockID(ctx)
+	sessionID := l.sessions.sessionID(l.groupID)
+	if err := l.verifyLockedSessionID(lid, sessionID, false); err != nil {
+		return err
+	}
+	if sessionID == cpNoSessionID {
+		l.removeLockedSessionID(lid)
+		msg := fmt.Sprintf("current lock context is not owner of the lock: %s", l.proxyName)
+		return ihzerrors.NewClientError(msg, nil, hzerrors.ErrIllegalMonitorState)
+	}
+	request := codec.EncodeFencedLockUnlockRequest(l.groupID, l.name, sessionID, lid, types.NewUUID())
+	response, err := l.invokeOnCPGroup(ctx, request)
+	if err != nil {
+		if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+			l.sessions.invalidateSession(l.groupID, sessionID)
+			l.removeLockedSessionID(lid)
+			return l.lockOwnershipLostError(sessionID)
+		}
+		if errors.Is(err, hzerrors.ErrIllegalMonitorState) {
+			l.removeLockedSessionID(lid)
+		}
+		return err
+	}
+	if stillLocked := codec.DecodeFencedLockUnlockResponse(response); stillLocked {
+		l.setLockedSessionID(lid, sessionID)
+	} else {
+		l.removeLockedSessionID(lid)
+	}
+	l.sessions.releaseSession(l.groupID, sessionID, 1)
+	return nil
+}
+
+func (l *FencedLock) tryLock(ctx context.Context, timeout time.Duration) (int64, error) {
+	lid := extractLockID(ctx)
+	invocationUID := types.NewUUID()
+	for {
+		start := time.Now()
+		sessionID, err := l.sessions.acquireSession(ctx, l.groupID, 1)
+		if err != nil {
+			return InvalidFence, err
+		}
+		if err := l.verifyLockedSessionID(lid, sessionID, true); err != nil {
+			return InvalidFence, err
+		}
+		request := codec.EncodeFencedLockTryLockRequest(l.groupID, l.name, sessionID, lid, invocationUID, timeout.Milliseconds())
+		response, err := l.invokeOnCPGroup(ctx, request)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				l.sessions.invalidateSession(l.groupID, sessionID)
+				if err := l.verifyNoLockedSessionID(lid); err != nil {
+					return InvalidFence, err
+				}
+				if timeout -= time.Since(start); timeout <= 0 {
+					return InvalidFence, nil
+				}
+				continue
+			}
+			l.sessions.releaseSession(l.groupID, sessionID, 1)
+			if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+				return InvalidFence, nil
+			}
+			return InvalidFence, err
+		}
+		fence := codec.DecodeFencedLockTryLockResponse(response)
+		if fence == InvalidFence {
+			l.sessions.releaseSession(l.groupID, sessionID, 1)
+		} else {
+			l.setLockedSessionID(lid, sessionID)
+		}
+		return fence, nil
+	}
+}
+
+// verifyLockedSessionID checks that the lock, if held by the lock context, is held within the given session.
+func (l *FencedLock) verifyLockedSessionID(lid int64, sessionID int64, releaseSession bool) error {
+	l.mu.Lock()
+	lockedSessionID, ok := l.lockedSessionIDs[lid]
+	if !ok || lockedSessionID == sessionID {
+		l.mu.Unlock()
+		return nil
+	}
+	delete(l.lockedSessionIDs, lid)
+	l.mu.Unlock()
+	if releaseSession {
+		l.sessions.releaseSession(l.groupID, sessionID, 1)
+	}
+	return l.lockOwnershipLostError(lockedSessionID)
+}
+
+// verifyNoLockedSessionID checks that the lock is not held by the lock context.
+func (l *FencedLock) verifyNoLockedSessionID(lid int64) error {
+	l.mu.Lock()
+	lockedSessionID, ok := l.lockedSessionIDs[lid]
+	delete(l.lockedSessionIDs, lid)
+	l.mu.Unlock()
+	if ok {
+		return l.lockOwnershipLostError(lockedSessionID)
+	}
+	return nil
+}
+
+func (l *FencedLock) setLockedSessionID(lid int64, sessionID int64) {
+	l.mu.Lock()
+	l.lockedSessionIDs[lid] = sessionID
+	l.mu.Unlock()
+}
+
+func (l *FencedLock) removeLockedSessionID(lid int64) {
+	l.mu.Lock()
+	delete(l.lockedSessionIDs, lid)
+	l.mu.Unlock()
+}
+
+func (l *FencedLock) lockOwnershipLostError(sessionID int64) error {
+	msg := fmt.Sprintf("current lock context is not owner of the lock %s because its session %d is closed", l.proxyName, sessionID)
+	return ihzerrors.NewClientError(msg, nil, hzerrors.ErrLockOwnershipLostException)
+}
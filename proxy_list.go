@@ -209,6 +209,12 @@ func (l *List) IsEmpty(ctx context.Context) (bool, error) {
 	return codec.DecodeListIsEmptyResponse(response), nil
 }
 
+// Iterator returns an iterator over the elements of this list, starting at the first element.
+// The elements are fetched from the cluster in batches, see ListIterator for the details.
+func (l *List) Iterator(ctx context.Context) (*ListIterator, error) {
+	return newListIterator(ctx, l, 0)
+}
+
 // LastIndexOf returns the index of the last occurrence of the given element in this list.
 func (l *List) LastIndexOf(ctx context.Context, element interface{}) (int, error) {
 	elementData, err := l.validateAndSerialize(element)
@@ -223,6 +229,13 @@ func (l *List) LastIndexOf(ctx context.Context, element interface{}) (int, error
 	return int(codec.DecodeListLastIndexOfResponse(response)), nil
 }
 
+// ListIterator returns an iterator over the elements of this list, starting at the given index.
+// The first call to Next returns the element at startIndex, the first call to Previous returns the element before it.
+// Returns hzerrors.ErrIndexOutOfBounds if startIndex is negative or greater than the size of the list.
+func (l *List) ListIterator(ctx context.Context, startIndex int) (*ListIterator, error) {
+	return newListIterator(ctx, l, startIndex)
+}
+
 // Remove removes the given element from this list.
 // Returns true if the list has changed as the result of this operation, false otherwise.
 func (l *List) Remove(ctx context.Context, element interface{}) (bool, error) {
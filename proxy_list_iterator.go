@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+)
+
+const defaultListIteratorBatchSize = 100
+
+/*
+ListIterator iterates over the elements of a List in both directions.
+
+The elements are fetched from the cluster in batches, so the whole list is never loaded into memory.
+The iterator does not provide a snapshot of the list: elements which are added, removed or moved during the iteration may be skipped or returned more than once.
+
+	it, err := l.ListIterator(ctx, 0)
+	if err != nil {
+		// handle the error
+	}
+	for {
+		if ok, err := it.HasNext(ctx); err != nil {
+			// handle the error
+		} else if !ok {
+			break
+		}
+		index := it.NextIndex()
+		element, err := it.Next(ctx)
+		// use the element at the index
+	}
+
+ListIterator is not concurrency-safe.
+*/
+type ListIterator struct {
+	l          *List
+	batch      []interface{}
+	batchStart int
+	batchSize  int
+	cursor     int
+	// size is the last known size of the list, it is fetched again when the list may have changed.
+	size int
+}
+
+func newListIterator(ctx context.Context, l *List, startIndex int) (*ListIterator, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	size, err := l.Size(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if startIndex < 0 || startIndex > size {
+		msg := fmt.Sprintf("index %d is out of range for list of size %d", startIndex, size)
+		return nil, ihzerrors.NewClientError(msg, nil, hzerrors.ErrIndexOutOfBounds)
+	}
+	it := &ListIterator{
+		l:         l,
+		batchSize: defaultListIteratorBatchSize,
+		cursor:    startIndex,
+		size:      size,
+	}
+	if _, err := it.HasNext(ctx); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// HasNext returns true if there is an element after the cursor.
+// It may fetch the next batch of elements from the cluster.
+func (it *ListIterator) HasNext(ctx context.Context) (bool, error) {
+	if it.inBatch(it.cursor) {
+		return true, nil
+	}
+	if it.cursor >= it.size {
+		// the list may have grown since its size was fetched
+		if err := it.refreshSize(ctx); err != nil {
+			return false, err
+		}
+	}
+	if err := it.fetch(ctx, it.cursor, it.cursor+it.batchSize); err != nil {
+		return false, err
+	}
+	return it.inBatch(it.cursor), nil
+}
+
+// Next returns the element after the cursor and moves the cursor forward.
+// Returns hzerrors.ErrNoSuchElement if there are no more elements.
+func (it *ListIterator) Next(ctx context.Context) (interface{}, error) {
+	if ok, err := it.HasNext(ctx); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, hzerrors.ErrNoSuchElement
+	}
+	element := it.batch[it.cursor-it.batchStart]
+	it.cursor++
+	return element, nil
+}
+
+// NextIndex returns the index of the element which would be returned by Next.
+func (it *ListIterator) NextIndex() int {
+	return it.cursor
+}
+
+// HasPrevious returns true if there is an element before the cursor.
+// It may fetch the previous batch of elements from the cluster.
+func (it *ListIterator) HasPrevious(ctx context.Context) (bool, error) {
+	if it.inBatch(it.cursor - 1) {
+		return true, nil
+	}
+	for it.cursor > 0 {
+		index := it.cursor - 1
+		if err := it.fetch(ctx, index+1-it.batchSize, index+1); err != nil {
+			return false, err
+		}
+		if it.inBatch(index) {
+			return true, nil
+		}
+		// the list shrank below the cursor, continue from the current end of the list
+		it.cursor = it.size
+	}
+	return false, nil
+}
+
+// Previous returns the element before the cursor and moves the cursor backward.
+// Returns hzerrors.ErrNoSuchElement if there are no previous elements.
+func (it *ListIterator) Previous(ctx context.Context) (interface{}, error) {
+	if ok, err := it.HasPrevious(ctx); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, hzerrors.ErrNoSuchElement
+	}
+	it.cursor--
+	return it.batch[it.cursor-it.batchStart], nil
+}
+
+// PreviousIndex returns the index of the element which would be returned by Previous.
+// Returns -1 if the cursor is at the beginning of the list.
+func (it *ListIterator) PreviousIndex() int {
+	return it.cursor - 1
+}
+
+func (it *ListIterator) inBatch(index int) bool {
+	return index >= it.batchStart && index < it.batchStart+len(it.batch)
+}
+
+// fetch replaces the current batch with the elements in [from, to), clamped to the last known size of the list.
+// If the list shrank since its size was fetched, the size is fetched again and the elements are requested again.
+func (it *ListIterator) fetch(ctx context.Context, from, to int) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if from < 0 {
+		from = 0
+	}
+	for {
+		end := to
+		if end > it.size {
+			end = it.size
+		}
+		it.batch = nil
+		it.batchStart = from
+		if from >= end {
+			return nil
+		}
+		elements, err := it.l.SubList(ctx, from, end)
+		if err == nil {
+			it.batch = elements
+			return nil
+		}
+		if !errors.Is(err, hzerrors.ErrIndexOutOfBounds) {
+			return err
+		}
+		if err := it.refreshSize(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (it *ListIterator) refreshSize(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	size, err := it.l.Size(ctx)
+	if err != nil {
+		return err
+	}
+	it.size = size
+	return nil
+}
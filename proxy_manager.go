@@ -18,9 +18,13 @@ package hazelcast
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
+	"github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
@@ -54,6 +58,9 @@ func newProxyManager(bundle creationBundle) *proxyManager {
 
 func (m *proxyManager) getMap(ctx context.Context, name string) (*Map, error) {
 	p, err := m.proxyFor(ctx, ServiceNameMap, name, func(p *proxy) (interface{}, error) {
+		if ncc, ok := m.serviceBundle.Config.NearCaches[name]; ok {
+			return newNearCachedMap(ctx, p, m.serviceBundle.NearCacheManager, ncc)
+		}
 		return newMap(p), nil
 	})
 	if err != nil {
@@ -64,6 +71,9 @@ func (m *proxyManager) getMap(ctx context.Context, name string) (*Map, error) {
 
 func (m *proxyManager) getReplicatedMap(ctx context.Context, name string) (*ReplicatedMap, error) {
 	p, err := m.proxyFor(ctx, ServiceNameReplicatedMap, name, func(p *proxy) (interface{}, error) {
+		if ncc, ok := m.serviceBundle.Config.NearCaches[name]; ok {
+			return newNearCachedReplicatedMap(ctx, p, m.refIDGenerator, m.serviceBundle.NearCacheManager, ncc)
+		}
 		return newReplicatedMap(p, m.refIDGenerator)
 	})
 	if err != nil {
@@ -142,6 +152,85 @@ func (m *proxyManager) getFlakeIDGenerator(ctx context.Context, name string) (*F
 	return p.(*FlakeIDGenerator), nil
 }
 
+func (m *proxyManager) getRingbuffer(ctx context.Context, name string) (*Ringbuffer, error) {
+	p, err := m.proxyFor(ctx, ServiceNameRingbuffer, name, func(p *proxy) (interface{}, error) {
+		return newRingbuffer(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*Ringbuffer), nil
+}
+
+func (m *proxyManager) getExecutorService(ctx context.Context, name string) (*ExecutorService, error) {
+	p, err := m.proxyFor(ctx, ServiceNameExecutorService, name, func(p *proxy) (interface{}, error) {
+		return newExecutorService(p), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*ExecutorService), nil
+}
+
+func (m *proxyManager) getDurableExecutorService(ctx context.Context, name string) (*DurableExecutorService, error) {
+	p, err := m.proxyFor(ctx, ServiceNameDurableExecutor, name, func(p *proxy) (interface{}, error) {
+		return newDurableExecutorService(p), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*DurableExecutorService), nil
+}
+
+func (m *proxyManager) getScheduledExecutorService(ctx context.Context, name string) (*ScheduledExecutorService, error) {
+	p, err := m.proxyFor(ctx, ServiceNameScheduledExecutor, name, func(p *proxy) (interface{}, error) {
+		return newScheduledExecutorService(p), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*ScheduledExecutorService), nil
+}
+
+func (m *proxyManager) getReliableTopic(ctx context.Context, name string) (*ReliableTopic, error) {
+	// the ringbuffer proxy is created before the topic proxy, since proxyFor is not reentrant
+	rb, err := m.getRingbuffer(ctx, reliableTopicRingbufferPrefix+name)
+	if err != nil {
+		return nil, err
+	}
+	p, err := m.proxyFor(ctx, ServiceNameReliableTopic, name, func(p *proxy) (interface{}, error) {
+		return newReliableTopic(p, rb), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*ReliableTopic), nil
+}
+
+func (m *proxyManager) getCardinalityEstimator(ctx context.Context, name string) (*CardinalityEstimator, error) {
+	p, err := m.proxyFor(ctx, ServiceNameCardinalityEstimator, name, func(p *proxy) (interface{}, error) {
+		return newCardinalityEstimator(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.(*CardinalityEstimator), nil
+}
+
+func (m *proxyManager) getCache(ctx context.Context, name string) (*Cache, error) {
+	p, err := m.proxyFor(ctx, ServiceNameCache, cacheManagerPrefix+name, func(p *proxy) (interface{}, error) {
+		return newCache(p, name), nil
+	})
+	if err != nil {
+		if errors.Is(err, hzerrors.ErrCacheNotExists) {
+			msg := fmt.Sprintf("cache %s is not configured on the cluster", name)
+			return nil, ihzerrors.NewClientError(msg, err, hzerrors.ErrCacheNotExists)
+		}
+		return nil, err
+	}
+	return p.(*Cache), nil
+}
+
 func (m *proxyManager) invokeOnRandomTarget(ctx context.Context, request *proto.ClientMessage, handler proto.ClientMessageHandler) (*proto.ClientMessage, error) {
 	return m.invocationProxy.invokeOnRandomTarget(ctx, request, handler)
 }
@@ -165,6 +254,30 @@ func (m *proxyManager) removeDistributedObjectEventListener(ctx context.Context,
 	return m.serviceBundle.ListenerBinder.Remove(ctx, subscriptionID)
 }
 
+func (m *proxyManager) addPartitionLostListener(ctx context.Context, handler PartitionLostHandler) (types.UUID, error) {
+	request := codec.EncodeClientAddPartitionLostListenerRequest(!m.serviceBundle.Config.Cluster.Unisocket)
+	subscriptionID := types.NewUUID()
+	removeRequest := codec.EncodeClientRemovePartitionLostListenerRequest(subscriptionID)
+	listenerHandler := func(msg *proto.ClientMessage) {
+		codec.HandleClientAddPartitionLostListener(msg, func(partitionID int32, lostBackupCount int32, source types.UUID) {
+			// prevent panic if member not found
+			var member cluster.MemberInfo
+			if mem := m.serviceBundle.ClusterService.GetMemberByUUID(source); mem != nil {
+				member = *mem
+			}
+			handler(newPartitionLost(partitionID, int(lostBackupCount), member))
+		})
+	}
+	if err := m.serviceBundle.ListenerBinder.Add(ctx, subscriptionID, request, removeRequest, listenerHandler); err != nil {
+		return types.UUID{}, err
+	}
+	return subscriptionID, nil
+}
+
+func (m *proxyManager) removePartitionLostListener(ctx context.Context, subscriptionID types.UUID) error {
+	return m.serviceBundle.ListenerBinder.Remove(ctx, subscriptionID)
+}
+
 func (m *proxyManager) remove(serviceName string, objectName string) bool {
 	name := makeProxyName(serviceName, objectName)
 	m.mu.Lock()
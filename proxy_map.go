@@ -20,16 +20,22 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/aggregate"
+	"github.com/hazelcast/hazelcast-go-client/cluster"
 	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
 	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/projection"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
 
@@ -106,10 +112,27 @@ You can pass any context.Context to any Map function, but in that case lock owne
 */
 type Map struct {
 	*proxy
+	ncm           *nearCacheMap
+	queryCachesMu *sync.Mutex
+	queryCaches   map[string]*QueryCache
 }
 
 func newMap(p *proxy) *Map {
-	return &Map{proxy: p}
+	return &Map{
+		proxy:         p,
+		queryCachesMu: &sync.Mutex{},
+		queryCaches:   map[string]*QueryCache{},
+	}
+}
+
+func newNearCachedMap(ctx context.Context, p *proxy, ncm *inearcache.Manager, cfg nearcache.Config) (*Map, error) {
+	ncMap, err := newNearCacheMap(ctx, p, ncm, cfg)
+	if err != nil {
+		return nil, err
+	}
+	m := newMap(p)
+	m.ncm = ncMap
+	return m, nil
 }
 
 // NewLockContext augments the passed parent context with a unique lock ID.
@@ -145,6 +168,26 @@ func (m *Map) AddInterceptor(ctx context.Context, interceptor interface{}) (stri
 	}
 }
 
+// AddPartitionLostListener adds a listener which is called when a partition which holds the entries of this map is lost.
+// Returns the subscription ID of the listener.
+func (m *Map) AddPartitionLostListener(ctx context.Context, handler MapPartitionLostHandler) (types.UUID, error) {
+	subscriptionID := types.NewUUID()
+	addRequest := codec.EncodeMapAddPartitionLostListenerRequest(m.name, m.smart)
+	removeRequest := codec.EncodeMapRemovePartitionLostListenerRequest(m.name, subscriptionID)
+	listenerHandler := func(msg *proto.ClientMessage) {
+		codec.HandleMapAddPartitionLostListener(msg, func(partitionID int32, uuid types.UUID) {
+			// prevent panic if member not found
+			var member cluster.MemberInfo
+			if mem := m.clusterService.GetMemberByUUID(uuid); mem != nil {
+				member = *mem
+			}
+			handler(newMapPartitionLost(m.name, partitionID, member))
+		})
+	}
+	err := m.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler)
+	return subscriptionID, err
+}
+
 // Aggregate runs the given aggregator and returns the result.
 func (m *Map) Aggregate(ctx context.Context, agg aggregate.Aggregator) (interface{}, error) {
 	aggData, err := m.validateAndSerializeAggregate(agg)
@@ -158,6 +201,9 @@ func (m *Map) Aggregate(ctx context.Context, agg aggregate.Aggregator) (interfac
 // AggregateWithPredicate runs the given aggregator and returns the result.
 // The result is filtered with the given predicate.
 func (m *Map) AggregateWithPredicate(ctx context.Context, agg aggregate.Aggregator, pred predicate.Predicate) (interface{}, error) {
+	if err := checkNotPagingPredicate(pred, "AggregateWithPredicate"); err != nil {
+		return nil, err
+	}
 	aggData, err := m.validateAndSerializeAggregate(agg)
 	if err != nil {
 		return nil, err
@@ -172,6 +218,7 @@ func (m *Map) AggregateWithPredicate(ctx context.Context, agg aggregate.Aggregat
 
 // Clear deletes all entries one by one and fires related events.
 func (m *Map) Clear(ctx context.Context) error {
+	defer m.clearNearCache()
 	request := codec.EncodeMapClearRequest(m.name)
 	_, err := m.invokeOnRandomTarget(ctx, request, nil)
 	return err
@@ -215,6 +262,7 @@ func (m *Map) Delete(ctx context.Context, key interface{}) error {
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapDeleteRequest(m.name, keyData, lid)
 		_, err := m.invokeOnKey(ctx, request, keyData)
 		return err
@@ -228,6 +276,7 @@ func (m *Map) Evict(ctx context.Context, key interface{}) (bool, error) {
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return false, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapEvictRequest(m.name, keyData, lid)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return false, err
@@ -237,8 +286,19 @@ func (m *Map) Evict(ctx context.Context, key interface{}) (bool, error) {
 	}
 }
 
+// Destroy removes this map from the cluster, together with its Near Cache if there is one.
+func (m *Map) Destroy(ctx context.Context) error {
+	if m.ncm != nil {
+		if err := m.ncm.Destroy(ctx); err != nil {
+			m.logger.Warnf("removing Near Cache invalidation listener of %s: %s", m.name, err.Error())
+		}
+	}
+	return m.proxy.Destroy(ctx)
+}
+
 // EvictAll deletes all entries without firing related events.
 func (m *Map) EvictAll(ctx context.Context) error {
+	defer m.clearNearCache()
 	request := codec.EncodeMapEvictAllRequest(m.name)
 	_, err := m.invokeOnRandomTarget(ctx, request, nil)
 	return err
@@ -246,6 +306,7 @@ func (m *Map) EvictAll(ctx context.Context) error {
 
 // ExecuteOnEntries applies the user defined EntryProcessor to all the entries in the map.
 func (m *Map) ExecuteOnEntries(ctx context.Context, entryProcessor interface{}) ([]types.Entry, error) {
+	defer m.clearNearCache()
 	processorData, err := m.validateAndSerialize(entryProcessor)
 	if err != nil {
 		return nil, err
@@ -265,6 +326,10 @@ func (m *Map) ExecuteOnEntries(ctx context.Context, entryProcessor interface{})
 
 // ExecuteOnEntriesWithPredicate applies the user defined EntryProcessor to all the entries in the map which satisfies the predicate.
 func (m *Map) ExecuteOnEntriesWithPredicate(ctx context.Context, entryProcessor interface{}, pred predicate.Predicate) ([]types.Entry, error) {
+	if err := checkNotPagingPredicate(pred, "ExecuteOnEntriesWithPredicate"); err != nil {
+		return nil, err
+	}
+	defer m.clearNearCache()
 	processorData, err := m.validateAndSerialize(entryProcessor)
 	if err != nil {
 		return nil, err
@@ -286,6 +351,53 @@ func (m *Map) ExecuteOnEntriesWithPredicate(ctx context.Context, entryProcessor
 	return kvPairs, nil
 }
 
+// ExecuteOnKey applies the user defined EntryProcessor to the entry with the given key and returns the result.
+func (m *Map) ExecuteOnKey(ctx context.Context, entryProcessor interface{}, key interface{}) (interface{}, error) {
+	lid := extractLockID(ctx)
+	if processorData, keyData, err := m.validateAndSerialize2(entryProcessor, key); err != nil {
+		return nil, err
+	} else {
+		defer m.invalidateNearCache(keyData)
+		request := codec.EncodeMapExecuteOnKeyRequest(m.name, processorData, keyData, lid)
+		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeMapExecuteOnKeyResponse(response))
+		}
+	}
+}
+
+// ExecuteOnKeys applies the user defined EntryProcessor to the entries with the given keys.
+// Returns the results mapped by the keys.
+func (m *Map) ExecuteOnKeys(ctx context.Context, entryProcessor interface{}, keys ...interface{}) ([]types.Entry, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	processorData, err := m.validateAndSerialize(entryProcessor)
+	if err != nil {
+		return nil, err
+	}
+	keyDatas := make([]*serialization.Data, 0, len(keys))
+	for _, key := range keys {
+		if keyData, err := m.validateAndSerialize(key); err != nil {
+			return nil, err
+		} else {
+			keyDatas = append(keyDatas, keyData)
+		}
+	}
+	defer func() {
+		for _, keyData := range keyDatas {
+			m.invalidateNearCache(keyData)
+		}
+	}()
+	request := codec.EncodeMapExecuteOnKeysRequest(m.name, processorData, keyDatas)
+	resp, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertPairsToEntries(codec.DecodeMapExecuteOnKeysResponse(resp))
+}
+
 // Flush flushes all the local dirty entries.
 func (m *Map) Flush(ctx context.Context) error {
 	request := codec.EncodeMapFlushRequest(m.name)
@@ -316,6 +428,15 @@ func (m *Map) Get(ctx context.Context, key interface{}) (interface{}, error) {
 		return nil, err
 	} else {
 		request := codec.EncodeMapGetRequest(m.name, keyData, lid)
+		if m.ncm != nil {
+			return m.ncm.Get(keyData, func() (*serialization.Data, error) {
+				response, err := m.invokeOnKey(ctx, request, keyData)
+				if err != nil {
+					return nil, err
+				}
+				return codec.DecodeMapGetResponse(response), nil
+			})
+		}
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
 		} else {
@@ -332,51 +453,32 @@ func (m *Map) GetAll(ctx context.Context, keys ...interface{}) ([]types.Entry, e
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	partitionToKeys := map[int32][]*serialization.Data{}
-	ps := m.proxy.partitionService
+	keyDatas := make([]*serialization.Data, 0, len(keys))
 	for _, key := range keys {
 		if keyData, err := m.validateAndSerialize(key); err != nil {
 			return nil, err
 		} else {
-			if partitionKey, err := ps.GetPartitionID(keyData); err != nil {
-				return nil, err
-			} else {
-				arr := partitionToKeys[partitionKey]
-				partitionToKeys[partitionKey] = append(arr, keyData)
-			}
+			keyDatas = append(keyDatas, keyData)
 		}
 	}
-	result := make([]types.Entry, 0, len(keys))
-	// create futures
-	f := func(partitionID int32, keys []*serialization.Data) cb.Future {
-		request := codec.EncodeMapGetAllRequest(m.name, keys)
-		return m.cb.TryContextFuture(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
-			if attempt > 0 {
-				request = request.Copy()
-			}
-			return m.invokeOnPartition(ctx, request, partitionID)
+	if m.ncm != nil {
+		return m.ncm.GetAll(keyDatas, func(keys []*serialization.Data) ([]proto.Pair, error) {
+			return m.invokeGetAll(ctx, keys)
 		})
 	}
-	futures := make([]cb.Future, 0, len(partitionToKeys))
-	for partitionID, keys := range partitionToKeys {
-		futures = append(futures, f(partitionID, keys))
+	pairs, err := m.invokeGetAll(ctx, keyDatas)
+	if err != nil {
+		return nil, err
 	}
-	for _, future := range futures {
-		if futureResult, err := future.Result(); err != nil {
+	result := make([]types.Entry, 0, len(pairs))
+	var key, value interface{}
+	for _, pair := range pairs {
+		if key, err = m.convertToObject(pair.Key().(*serialization.Data)); err != nil {
+			return nil, err
+		} else if value, err = m.convertToObject(pair.Value().(*serialization.Data)); err != nil {
 			return nil, err
-		} else {
-			pairs := codec.DecodeMapGetAllResponse(futureResult.(*proto.ClientMessage))
-			var key, value interface{}
-			var err error
-			for _, pair := range pairs {
-				if key, err = m.convertToObject(pair.Key().(*serialization.Data)); err != nil {
-					return nil, err
-				} else if value, err = m.convertToObject(pair.Value().(*serialization.Data)); err != nil {
-					return nil, err
-				}
-				result = append(result, types.NewEntry(key, value))
-			}
 		}
+		result = append(result, types.NewEntry(key, value))
 	}
 	return result, nil
 }
@@ -392,12 +494,17 @@ func (m *Map) GetEntrySet(ctx context.Context) ([]types.Entry, error) {
 }
 
 // GetEntrySetWithPredicate returns a clone of the mappings contained in this map.
-func (m *Map) GetEntrySetWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]types.Entry, error) {
-	if predData, err := m.validateAndSerialize(predicate); err != nil {
+// If the predicate is a paging predicate, only the entries in the current page are returned.
+// If the predicate is a partition predicate, only the partition of the partition key is queried.
+func (m *Map) GetEntrySetWithPredicate(ctx context.Context, pred predicate.Predicate) ([]types.Entry, error) {
+	if pp, partitionKey, ok := extractPagingPredicate(pred); ok {
+		return m.getEntrySetWithPagingPredicate(ctx, pp, partitionKey)
+	}
+	if predData, err := m.validateAndSerialize(pred); err != nil {
 		return nil, err
 	} else {
 		request := codec.EncodeMapEntriesWithPredicateRequest(m.name, predData)
-		if response, err := m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		if response, err := m.invokeWithPredicate(ctx, request, pred); err != nil {
 			return nil, err
 		} else {
 			return m.convertPairsToEntries(codec.DecodeMapEntriesWithPredicateResponse(response))
@@ -467,12 +574,17 @@ func (m *Map) GetKeySet(ctx context.Context) ([]interface{}, error) {
 }
 
 // GetKeySetWithPredicate returns keys contained in this map.
-func (m *Map) GetKeySetWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
-	if predicateData, err := m.validateAndSerializePredicate(predicate); err != nil {
+// If the predicate is a paging predicate, only the keys in the current page are returned.
+// If the predicate is a partition predicate, only the partition of the partition key is queried.
+func (m *Map) GetKeySetWithPredicate(ctx context.Context, pred predicate.Predicate) ([]interface{}, error) {
+	if pp, partitionKey, ok := extractPagingPredicate(pred); ok {
+		return m.getKeySetWithPagingPredicate(ctx, pp, partitionKey)
+	}
+	if predicateData, err := m.validateAndSerializePredicate(pred); err != nil {
 		return nil, err
 	} else {
 		request := codec.EncodeMapKeySetWithPredicateRequest(m.name, predicateData)
-		if response, err := m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		if response, err := m.invokeWithPredicate(ctx, request, pred); err != nil {
 			return nil, err
 		} else {
 			return m.convertToObjects(codec.DecodeMapKeySetWithPredicateResponse(response))
@@ -480,6 +592,50 @@ func (m *Map) GetKeySetWithPredicate(ctx context.Context, predicate predicate.Pr
 	}
 }
 
+// GetQueryCache returns the query cache with the given name, which contains the entries of this map that match the given predicate.
+// The query cache is created if it does not exist, otherwise the existing one is returned and the other arguments are ignored.
+// If includeValue is false, the query cache contains only the keys of the entries.
+// If pred is nil, all entries of this map are included.
+func (m *Map) GetQueryCache(ctx context.Context, name string, pred predicate.Predicate, includeValue bool) (*QueryCache, error) {
+	if err := checkQueryCacheName(name); err != nil {
+		return nil, err
+	}
+	if err := checkNotPagingPredicate(pred, "query caches"); err != nil {
+		return nil, err
+	}
+	m.queryCachesMu.Lock()
+	qc, ok := m.queryCaches[name]
+	m.queryCachesMu.Unlock()
+	if ok {
+		return qc, nil
+	}
+	if pred == nil {
+		pred = predicate.True()
+	}
+	predData, err := m.validateAndSerialize(pred)
+	if err != nil {
+		return nil, err
+	}
+	qc = newQueryCache(m, name, predData, includeValue)
+	if err := qc.start(ctx); err != nil {
+		return nil, err
+	}
+	m.queryCachesMu.Lock()
+	existing, ok := m.queryCaches[name]
+	if !ok {
+		m.queryCaches[name] = qc
+	}
+	m.queryCachesMu.Unlock()
+	if ok {
+		// another query cache with the same name was created in the meantime
+		if err := qc.Destroy(ctx); err != nil {
+			m.logger.Warnf("error destroying query cache %s: %v", name, err)
+		}
+		return existing, nil
+	}
+	return qc, nil
+}
+
 // GetValues returns a list clone of the values contained in this map.
 func (m *Map) GetValues(ctx context.Context) ([]interface{}, error) {
 	request := codec.EncodeMapValuesRequest(m.name)
@@ -491,12 +647,17 @@ func (m *Map) GetValues(ctx context.Context) ([]interface{}, error) {
 }
 
 // GetValuesWithPredicate returns a list clone of the values contained in this map.
-func (m *Map) GetValuesWithPredicate(ctx context.Context, predicate predicate.Predicate) ([]interface{}, error) {
-	if predicateData, err := m.validateAndSerializePredicate(predicate); err != nil {
+// If the predicate is a paging predicate, only the values in the current page are returned.
+// If the predicate is a partition predicate, only the partition of the partition key is queried.
+func (m *Map) GetValuesWithPredicate(ctx context.Context, pred predicate.Predicate) ([]interface{}, error) {
+	if pp, partitionKey, ok := extractPagingPredicate(pred); ok {
+		return m.getValuesWithPagingPredicate(ctx, pp, partitionKey)
+	}
+	if predicateData, err := m.validateAndSerializePredicate(pred); err != nil {
 		return nil, err
 	} else {
 		request := codec.EncodeMapValuesWithPredicateRequest(m.name, predicateData)
-		if response, err := m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		if response, err := m.invokeWithPredicate(ctx, request, pred); err != nil {
 			return nil, err
 		} else {
 			return m.convertToObjects(codec.DecodeMapValuesWithPredicateResponse(response))
@@ -528,6 +689,28 @@ func (m *Map) IsLocked(ctx context.Context, key interface{}) (bool, error) {
 	}
 }
 
+// IterateEntries returns an iterator over the entries of this map.
+// The entries are fetched from the cluster in batches of at most batchSize entries, so the whole map is never loaded into memory.
+// The first batch is fetched before this function returns.
+func (m *Map) IterateEntries(ctx context.Context, batchSize int) (*MapEntryIterator, error) {
+	it, err := m.iterate(ctx, batchSize, m.fetchEntriesBatch)
+	if err != nil {
+		return nil, err
+	}
+	return &MapEntryIterator{it: it}, nil
+}
+
+// IterateKeys returns an iterator over the keys of this map.
+// The keys are fetched from the cluster in batches of at most batchSize keys, so the whole key set is never loaded into memory.
+// The first batch is fetched before this function returns.
+func (m *Map) IterateKeys(ctx context.Context, batchSize int) (*MapKeyIterator, error) {
+	it, err := m.iterate(ctx, batchSize, m.fetchKeysBatch)
+	if err != nil {
+		return nil, err
+	}
+	return &MapKeyIterator{it: it}, nil
+}
+
 // LoadAllWithoutReplacing loads all keys from the store at server side or loads the given keys if provided.
 func (m *Map) LoadAllWithoutReplacing(ctx context.Context, keys ...interface{}) error {
 	if len(keys) == 0 {
@@ -570,6 +753,41 @@ func (m *Map) LockWithLease(ctx context.Context, key interface{}, leaseTime time
 	return m.lock(ctx, key, leaseTime.Milliseconds())
 }
 
+// Project applies the projection to all entries of the map and returns the projected values.
+func (m *Map) Project(ctx context.Context, proj projection.Projection) ([]interface{}, error) {
+	projData, err := m.validateAndSerializeProjection(proj)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapProjectRequest(m.name, projData)
+	response, err := m.invokeOnRandomTarget(ctx, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeMapProjectResponse(response))
+}
+
+// ProjectWithPredicate applies the projection to the entries of the map which satisfy the predicate and returns the projected values.
+func (m *Map) ProjectWithPredicate(ctx context.Context, proj projection.Projection, pred predicate.Predicate) ([]interface{}, error) {
+	if err := checkNotPagingPredicate(pred, "ProjectWithPredicate"); err != nil {
+		return nil, err
+	}
+	projData, err := m.validateAndSerializeProjection(proj)
+	if err != nil {
+		return nil, err
+	}
+	predData, err := m.validateAndSerializePredicate(pred)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapProjectWithPredicateRequest(m.name, projData, predData)
+	response, err := m.invokeWithPredicate(ctx, request, pred)
+	if err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(codec.DecodeMapProjectWithPredicateResponse(response))
+}
+
 // Put sets the value for the given key and returns the old value.
 func (m *Map) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
 	return m.putWithTTL(ctx, key, value, ttlUnset)
@@ -598,6 +816,7 @@ func (m *Map) PutWithTTLAndMaxIdle(ctx context.Context, key interface{}, value i
 // No atomicity guarantees are given. In the case of a failure, some key-value tuples may get written,
 // while others are not.
 func (m *Map) PutAll(ctx context.Context, entries ...types.Entry) error {
+	defer m.clearNearCache()
 	if len(entries) == 0 {
 		return nil
 	}
@@ -640,6 +859,7 @@ func (m *Map) PutIfAbsentWithTTLAndMaxIdle(ctx context.Context, key interface{},
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapPutIfAbsentWithMaxIdleRequest(m.name, keyData, valueData, lid, ttl.Milliseconds(), maxIdle.Milliseconds())
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -689,6 +909,7 @@ func (m *Map) Remove(ctx context.Context, key interface{}) (interface{}, error)
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapRemoveRequest(m.name, keyData, lid)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -700,6 +921,10 @@ func (m *Map) Remove(ctx context.Context, key interface{}) (interface{}, error)
 
 // RemoveAll deletes all entries matching the given predicate.
 func (m *Map) RemoveAll(ctx context.Context, predicate predicate.Predicate) error {
+	if err := checkNotPagingPredicate(predicate, "RemoveAll"); err != nil {
+		return err
+	}
+	defer m.clearNearCache()
 	if predicateData, err := m.validateAndSerialize(predicate); err != nil {
 		return err
 	} else {
@@ -724,6 +949,11 @@ func (m *Map) RemoveInterceptor(ctx context.Context, registrationID string) (boo
 	}
 }
 
+// RemovePartitionLostListener removes the partition lost listener with the given subscription ID.
+func (m *Map) RemovePartitionLostListener(ctx context.Context, subscriptionID types.UUID) error {
+	return m.listenerBinder.Remove(ctx, subscriptionID)
+}
+
 // RemoveIfSame removes the entry for a key only if it is currently mapped to a given value.
 // Returns true if the entry was removed.
 func (m *Map) RemoveIfSame(ctx context.Context, key interface{}, value interface{}) (bool, error) {
@@ -731,6 +961,7 @@ func (m *Map) RemoveIfSame(ctx context.Context, key interface{}, value interface
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return false, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapRemoveIfSameRequest(m.name, keyData, valueData, lid)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return false, err
@@ -746,6 +977,7 @@ func (m *Map) Replace(ctx context.Context, key interface{}, value interface{}) (
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapReplaceRequest(m.name, keyData, valueData, lid)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -762,6 +994,7 @@ func (m *Map) ReplaceIfSame(ctx context.Context, key interface{}, oldValue inter
 	if keyData, oldValueData, newValueData, err := m.validateAndSerialize3(key, oldValue, newValue); err != nil {
 		return false, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapReplaceIfSameRequest(m.name, keyData, oldValueData, newValueData, lid)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return false, err
@@ -783,6 +1016,7 @@ func (m *Map) SetTTL(ctx context.Context, key interface{}, ttl time.Duration) er
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapSetTtlRequest(m.name, keyData, ttl.Milliseconds())
 		_, err := m.invokeOnKey(ctx, request, keyData)
 		return err
@@ -806,6 +1040,7 @@ func (m *Map) SetWithTTLAndMaxIdle(ctx context.Context, key interface{}, value i
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapSetWithMaxIdleRequest(m.name, keyData, valueData, lid, ttl.Milliseconds(), maxIdle.Milliseconds())
 		_, err := m.invokeOnKey(ctx, request, keyData)
 		return err
@@ -822,6 +1057,29 @@ func (m *Map) Size(ctx context.Context) (int, error) {
 	}
 }
 
+// SubmitToKey applies the user defined EntryProcessor to the entry with the given key asynchronously.
+// The result of the EntryProcessor is available through the returned future.
+// The operation is cancelled if the given context is done before it completes.
+func (m *Map) SubmitToKey(ctx context.Context, entryProcessor interface{}, key interface{}) (*Future, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	lid := extractLockID(ctx)
+	processorData, keyData, err := m.validateAndSerialize2(entryProcessor, key)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapSubmitToKeyRequest(m.name, processorData, keyData, lid)
+	return newFuture(func() (interface{}, error) {
+		defer m.invalidateNearCache(keyData)
+		response, err := m.invokeOnKey(ctx, request, keyData)
+		if err != nil {
+			return nil, err
+		}
+		return m.convertToObject(codec.DecodeMapSubmitToKeyResponse(response))
+	}), nil
+}
+
 // TryLock tries to acquire the lock for the specified key.
 // When the lock is not available, the current goroutine doesn't wait and returns false immediately.
 func (m *Map) TryLock(ctx context.Context, key interface{}) (bool, error) {
@@ -890,6 +1148,9 @@ func (m *Map) addIndex(ctx context.Context, indexConfig types.IndexConfig) error
 }
 
 func (m *Map) addEntryListener(ctx context.Context, flags int32, includeValue bool, key interface{}, predicate predicate.Predicate, handler EntryNotifiedHandler) (types.UUID, error) {
+	if err := checkNotPagingPredicate(predicate, "entry listeners"); err != nil {
+		return types.UUID{}, err
+	}
 	var err error
 	var keyData *serialization.Data
 	var predicateData *serialization.Data
@@ -913,7 +1174,17 @@ func (m *Map) addEntryListener(ctx context.Context, flags int32, includeValue bo
 	return subscriptionID, err
 }
 
+// removeQueryCache removes the given query cache, if it is the one registered with its name.
+func (m *Map) removeQueryCache(qc *QueryCache) {
+	m.queryCachesMu.Lock()
+	if m.queryCaches[qc.name] == qc {
+		delete(m.queryCaches, qc.name)
+	}
+	m.queryCachesMu.Unlock()
+}
+
 func (m *Map) loadAll(ctx context.Context, replaceExisting bool, keys ...interface{}) error {
+	defer m.clearNearCache()
 	if len(keys) == 0 {
 		return nil
 	}
@@ -935,6 +1206,21 @@ func (m *Map) loadAll(ctx context.Context, replaceExisting bool, keys ...interfa
 	return err
 }
 
+func (m *Map) iterate(ctx context.Context, batchSize int, makeFetchFn func(batchSize int32) fetchBatchFn) (*mapPartitionIterator, error) {
+	bs, err := check.NonNegativeInt32(batchSize)
+	if err != nil {
+		return nil, err
+	}
+	if bs == 0 {
+		return nil, ihzerrors.NewIllegalArgumentError("batch size must be positive", nil)
+	}
+	it := newMapPartitionIterator(m.proxy, makeFetchFn(bs))
+	if _, err := it.HasNext(ctx); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
 func (m *Map) lock(ctx context.Context, key interface{}, ttl int64) error {
 	lid := extractLockID(ctx)
 	if keyData, err := m.validateAndSerialize(key); err != nil {
@@ -952,6 +1238,7 @@ func (m *Map) putWithTTL(ctx context.Context, key interface{}, value interface{}
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapPutRequest(m.name, keyData, valueData, lid, ttl)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -966,6 +1253,7 @@ func (m *Map) putMaxIdle(ctx context.Context, key interface{}, value interface{}
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapPutWithMaxIdleRequest(m.name, keyData, valueData, lid, ttl, maxIdle)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -980,6 +1268,7 @@ func (m *Map) putIfAbsent(ctx context.Context, key interface{}, value interface{
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapPutIfAbsentRequest(m.name, keyData, valueData, lid, ttl)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -994,6 +1283,7 @@ func (m *Map) putTransient(ctx context.Context, key interface{}, value interface
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapPutTransientRequest(m.name, keyData, valueData, lid, ttl)
 		_, err = m.invokeOnKey(ctx, request, keyData)
 		return err
@@ -1005,6 +1295,7 @@ func (m *Map) putTransientWithMaxIdle(ctx context.Context, key interface{}, valu
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapPutTransientWithMaxIdleRequest(m.name, keyData, valueData, lid, ttl, maxIdle)
 		_, err = m.invokeOnKey(ctx, request, keyData)
 		return err
@@ -1058,6 +1349,7 @@ func (m *Map) set(ctx context.Context, key interface{}, value interface{}, ttl i
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapSetRequest(m.name, keyData, valueData, lid, ttl)
 		_, err := m.invokeOnKey(ctx, request, keyData)
 		return err
@@ -1069,6 +1361,7 @@ func (m *Map) tryPut(ctx context.Context, key interface{}, value interface{}, ti
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return false, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapTryPutRequest(m.name, keyData, valueData, lid, timeout)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return false, err
@@ -1083,6 +1376,7 @@ func (m *Map) tryRemove(ctx context.Context, key interface{}, timeout int64) (in
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return false, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeMapTryRemoveRequest(m.name, keyData, lid, timeout)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -1102,10 +1396,15 @@ func (m *Map) aggregate(ctx context.Context, req *proto.ClientMessage, decoder f
 	if err != nil {
 		return nil, err
 	}
-	// if this is a canonicalizing dereference it
-	cs, ok := obj.(*iproxy.AggCanonicalizingSet)
-	if ok {
-		return *cs, nil
+	switch v := obj.(type) {
+	case *iproxy.AggCanonicalizingSet:
+		// if this is a canonicalizing dereference it
+		return *v, nil
+	case *iproxy.LazyMapEntry:
+		// MinBy and MaxBy return an entry
+		return types.NewEntry(v.Key, v.Value), nil
+	case *iproxy.LockAwareLazyMapEntry:
+		return types.NewEntry(v.Key, v.Value), nil
 	}
 	return obj, nil
 }
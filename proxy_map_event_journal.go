@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// EventJournalEventType is the type of an event in the event journal of a map.
+type EventJournalEventType int32
+
+const (
+	// EventJournalInserted is recorded when an entry is added.
+	EventJournalInserted EventJournalEventType = 1 << 0
+	// EventJournalRemoved is recorded when an entry is removed.
+	EventJournalRemoved EventJournalEventType = 1 << 1
+	// EventJournalUpdated is recorded when the value of an entry is updated.
+	EventJournalUpdated EventJournalEventType = 1 << 2
+	// EventJournalEvicted is recorded when an entry is evicted.
+	EventJournalEvicted EventJournalEventType = 1 << 3
+	// EventJournalExpired is recorded when an entry expires.
+	EventJournalExpired EventJournalEventType = 1 << 4
+)
+
+func (t EventJournalEventType) String() string {
+	switch t {
+	case EventJournalInserted:
+		return "INSERTED"
+	case EventJournalRemoved:
+		return "REMOVED"
+	case EventJournalUpdated:
+		return "UPDATED"
+	case EventJournalEvicted:
+		return "EVICTED"
+	case EventJournalExpired:
+		return "EXPIRED"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", int32(t))
+	}
+}
+
+// EventJournalState contains the sequences of the oldest and newest events in the event journal of a partition.
+// The journal is empty if NewestSequence is less than OldestSequence.
+type EventJournalState struct {
+	OldestSequence int64
+	NewestSequence int64
+}
+
+// EventJournalMapEvent is an event read from the event journal of a map.
+// NewValue is nil for removals, OldValue is nil for insertions.
+type EventJournalMapEvent struct {
+	Key       interface{}
+	NewValue  interface{}
+	OldValue  interface{}
+	Sequence  int64
+	EventType EventJournalEventType
+}
+
+// EventJournalReadResult is the result of reading a batch of events from the event journal of a map.
+type EventJournalReadResult struct {
+	// Events contains the events read from the journal, if no projection was given.
+	Events []EventJournalMapEvent
+	// Projected contains the results of applying the projection to the events, if a projection was given.
+	Projected []interface{}
+	// Sequences contains the sequence of each item in Events or Projected.
+	Sequences []int64
+	// ReadCount is the number of events read from the journal, including the ones which did not pass the predicate.
+	ReadCount int
+	// NextSequence is the sequence to read the next batch from.
+	// It may be greater than the sequence of the last returned item, since the predicate may filter out some events.
+	NextSequence int64
+}
+
+/*
+ReadFromEventJournal reads a batch of events from the event journal of the given partition, starting from startSeq.
+
+The call blocks until at least minSize events are available, or the context is done. At most maxSize events are returned.
+The event journal must be enabled in the map configuration on the members.
+Use SubscribeToEventJournal to find out the oldest sequence that is still available in the journal.
+If startSeq is older than the oldest available sequence, the read continues from the oldest one,
+so NextSequence of the result should be used for the next read.
+
+The predicate and projection are optional and run on the members.
+They must be serializable objects with counterparts on the members implementing
+com.hazelcast.function.PredicateEx and com.hazelcast.function.FunctionEx for com.hazelcast.map.EventJournalMapEvent.
+*/
+func (m *Map) ReadFromEventJournal(ctx context.Context, partitionID int32, startSeq int64, minSize, maxSize int, predicate, projection interface{}) (*EventJournalReadResult, error) {
+	if err := m.checkJournalPartition(partitionID); err != nil {
+		return nil, err
+	}
+	minSize32, err := check.NonNegativeInt32(minSize)
+	if err != nil {
+		return nil, err
+	}
+	maxSize32, err := check.NonNegativeInt32(maxSize)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize32 < minSize32 {
+		return nil, ihzerrors.NewIllegalArgumentError("maxSize must not be less than minSize", nil)
+	}
+	var predicateData, projectionData *iserialization.Data
+	if predicate != nil {
+		if predicateData, err = m.validateAndSerialize(predicate); err != nil {
+			return nil, err
+		}
+	}
+	if projection != nil {
+		if projectionData, err = m.validateAndSerialize(projection); err != nil {
+			return nil, err
+		}
+	}
+	request := codec.EncodeMapEventJournalReadRequest(m.name, startSeq, minSize32, maxSize32, predicateData, projectionData)
+	response, err := m.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return nil, err
+	}
+	readCount, items, sequences, nextSeq := codec.DecodeMapEventJournalReadResponse(response)
+	result := &EventJournalReadResult{
+		Sequences:    sequences,
+		ReadCount:    int(readCount),
+		NextSequence: nextSeq,
+	}
+	if projection != nil {
+		if result.Projected, err = m.convertToObjects(items); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	result.Events = make([]EventJournalMapEvent, len(items))
+	for i, item := range items {
+		if result.Events[i], err = m.convertToJournalEvent(item); err != nil {
+			return nil, err
+		}
+		if i < len(sequences) {
+			result.Events[i].Sequence = sequences[i]
+		}
+	}
+	return result, nil
+}
+
+// SubscribeToEventJournal returns the sequences of the oldest and newest events in the event journal of the given partition.
+// The oldest sequence can be used as the start sequence of ReadFromEventJournal to replay all available events.
+func (m *Map) SubscribeToEventJournal(ctx context.Context, partitionID int32) (EventJournalState, error) {
+	if err := m.checkJournalPartition(partitionID); err != nil {
+		return EventJournalState{}, err
+	}
+	request := codec.EncodeMapEventJournalSubscribeRequest(m.name)
+	response, err := m.invokeOnPartition(ctx, request, partitionID)
+	if err != nil {
+		return EventJournalState{}, err
+	}
+	oldest, newest := codec.DecodeMapEventJournalSubscribeResponse(response)
+	return EventJournalState{OldestSequence: oldest, NewestSequence: newest}, nil
+}
+
+func (m *Map) checkJournalPartition(partitionID int32) error {
+	if count := m.partitionService.PartitionCount(); partitionID < 0 || partitionID >= count {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("partition ID must be in range [0, %d)", count), nil)
+	}
+	return nil
+}
+
+func (m *Map) convertToJournalEvent(item *iserialization.Data) (EventJournalMapEvent, error) {
+	obj, err := m.convertToObject(item)
+	if err != nil {
+		return EventJournalMapEvent{}, err
+	}
+	e, ok := obj.(*iproxy.EventJournalMapEvent)
+	if !ok {
+		return EventJournalMapEvent{}, ihzerrors.NewSerializationError(fmt.Sprintf("unexpected event journal item: %T", obj), nil)
+	}
+	event := EventJournalMapEvent{EventType: EventJournalEventType(e.EventType)}
+	if event.Key, err = m.convertJournalPayload(e.Key); err != nil {
+		return EventJournalMapEvent{}, err
+	}
+	if event.NewValue, err = m.convertJournalPayload(e.NewValue); err != nil {
+		return EventJournalMapEvent{}, err
+	}
+	if event.OldValue, err = m.convertJournalPayload(e.OldValue); err != nil {
+		return EventJournalMapEvent{}, err
+	}
+	return event, nil
+}
+
+func (m *Map) convertJournalPayload(payload []byte) (interface{}, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	return m.convertToObject(iserialization.NewData(payload))
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"math"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+MapEntryIterator iterates over the entries of a Map.
+
+The entries are fetched from the cluster partition by partition, in batches.
+Only a single batch is kept in memory at any time.
+The iterator does not provide a snapshot of the map: entries which are added or removed during the iteration may or may not be returned.
+Partition migrations during the iteration do not cause an entry to be returned more than once.
+
+	it, err := m.IterateEntries(ctx, 100)
+	if err != nil {
+		// handle the error
+	}
+	for {
+		if ok, err := it.HasNext(ctx); err != nil {
+			// handle the error
+		} else if !ok {
+			break
+		}
+		entry, err := it.Next(ctx)
+		// use the entry
+	}
+
+MapEntryIterator is not concurrency-safe.
+*/
+type MapEntryIterator struct {
+	it *mapPartitionIterator
+}
+
+// HasNext returns true if there are more entries.
+// It may fetch the next batch of entries from the cluster.
+func (it *MapEntryIterator) HasNext(ctx context.Context) (bool, error) {
+	return it.it.HasNext(ctx)
+}
+
+// Next returns the next entry.
+// Returns hzerrors.ErrNoSuchElement if there are no more entries.
+func (it *MapEntryIterator) Next(ctx context.Context) (types.Entry, error) {
+	item, err := it.it.Next(ctx)
+	if err != nil {
+		return types.Entry{}, err
+	}
+	pair := item.(proto.Pair)
+	key, err := it.it.p.convertToObject(pair.Key().(*serialization.Data))
+	if err != nil {
+		return types.Entry{}, err
+	}
+	value, err := it.it.p.convertToObject(pair.Value().(*serialization.Data))
+	if err != nil {
+		return types.Entry{}, err
+	}
+	return types.NewEntry(key, value), nil
+}
+
+// MapKeyIterator iterates over the keys of a Map.
+// See MapEntryIterator for the details of the iteration.
+// MapKeyIterator is not concurrency-safe.
+type MapKeyIterator struct {
+	it *mapPartitionIterator
+}
+
+// HasNext returns true if there are more keys.
+// It may fetch the next batch of keys from the cluster.
+func (it *MapKeyIterator) HasNext(ctx context.Context) (bool, error) {
+	return it.it.HasNext(ctx)
+}
+
+// Next returns the next key.
+// Returns hzerrors.ErrNoSuchElement if there are no more keys.
+func (it *MapKeyIterator) Next(ctx context.Context) (interface{}, error) {
+	item, err := it.it.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return it.it.p.convertToObject(item.(*serialization.Data))
+}
+
+type fetchBatchFn func(ctx context.Context, partitionID int32, pointers []proto.Pair) ([]proto.Pair, []interface{}, error)
+
+// mapPartitionIterator walks the partitions one by one and fetches their items in batches.
+// Iteration pointers returned by the member are sent back with the next request,
+// so the member can continue from where the previous batch left, even if the partition was migrated or resized.
+type mapPartitionIterator struct {
+	p              *proxy
+	fetchFn        fetchBatchFn
+	pointers       []proto.Pair
+	batch          []interface{}
+	index          int
+	partitionID    int32
+	partitionCount int32
+}
+
+func newMapPartitionIterator(p *proxy, fetchFn fetchBatchFn) *mapPartitionIterator {
+	return &mapPartitionIterator{
+		p:              p,
+		fetchFn:        fetchFn,
+		pointers:       initialIterationPointers(),
+		partitionCount: p.partitionService.PartitionCount(),
+	}
+}
+
+func (it *mapPartitionIterator) HasNext(ctx context.Context) (bool, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for it.index >= len(it.batch) {
+		if it.partitionID >= it.partitionCount {
+			return false, nil
+		}
+		if err := it.fetch(ctx); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (it *mapPartitionIterator) Next(ctx context.Context) (interface{}, error) {
+	if ok, err := it.HasNext(ctx); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, hzerrors.ErrNoSuchElement
+	}
+	item := it.batch[it.index]
+	it.batch[it.index] = nil
+	it.index++
+	return item, nil
+}
+
+func (it *mapPartitionIterator) fetch(ctx context.Context) error {
+	pointers, items, err := it.fetchFn(ctx, it.partitionID, it.pointers)
+	if err != nil {
+		return err
+	}
+	it.batch = items
+	it.index = 0
+	if len(pointers) == 0 || pointers[len(pointers)-1].Key().(int32) < 0 {
+		// the partition is exhausted, continue with the next one
+		it.partitionID++
+		it.pointers = initialIterationPointers()
+		return nil
+	}
+	it.pointers = pointers
+	return nil
+}
+
+func initialIterationPointers() []proto.Pair {
+	// iteration pointers are (index, size) pairs, size -1 means the table size is not known yet
+	return []proto.Pair{proto.NewPair(int32(math.MaxInt32), int32(-1))}
+}
+
+func (m *Map) fetchEntriesBatch(batchSize int32) fetchBatchFn {
+	return func(ctx context.Context, partitionID int32, pointers []proto.Pair) ([]proto.Pair, []interface{}, error) {
+		request := codec.EncodeMapFetchEntriesRequest(m.name, pointers, batchSize)
+		response, err := m.invokeOnPartition(ctx, request, partitionID)
+		if err != nil {
+			return nil, nil, err
+		}
+		newPointers, entries := codec.DecodeMapFetchEntriesResponse(response)
+		items := make([]interface{}, len(entries))
+		for i, entry := range entries {
+			items[i] = entry
+		}
+		return newPointers, items, nil
+	}
+}
+
+func (m *Map) fetchKeysBatch(batchSize int32) fetchBatchFn {
+	return func(ctx context.Context, partitionID int32, pointers []proto.Pair) ([]proto.Pair, []interface{}, error) {
+		request := codec.EncodeMapFetchKeysRequest(m.name, pointers, batchSize)
+		response, err := m.invokeOnPartition(ctx, request, partitionID)
+		if err != nil {
+			return nil, nil, err
+		}
+		newPointers, keys := codec.DecodeMapFetchKeysResponse(response)
+		items := make([]interface{}, len(keys))
+		for i, key := range keys {
+			items[i] = key
+		}
+		return newPointers, items, nil
+	}
+}
@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// nearCacheMap keeps the Near Cache of a Map or ReplicatedMap and the invalidation listener which keeps it up to date.
+type nearCacheMap struct {
+	nc             *inearcache.NearCache
+	ncm            *inearcache.Manager
+	p              *proxy
+	subscriptionID types.UUID
+}
+
+func newNearCacheMap(ctx context.Context, p *proxy, ncm *inearcache.Manager, cfg nearcache.Config) (*nearCacheMap, error) {
+	nc, err := ncm.GetOrCreateNearCache(ctx, p.serviceName, p.name, cfg)
+	if err != nil {
+		return nil, err
+	}
+	ncMap := &nearCacheMap{
+		nc:             nc,
+		ncm:            ncm,
+		p:              p,
+		subscriptionID: types.NewUUID(),
+	}
+	handler := nc.RepairingHandler()
+	addRequest := codec.EncodeMapAddNearCacheInvalidationListenerRequest(p.name, int32(EntryInvalidated), p.smart)
+	removeRequest := codec.EncodeMapRemoveEntryListenerRequest(p.name, ncMap.subscriptionID)
+	listenerHandler := func(msg *proto.ClientMessage) {
+		codec.HandleMapAddNearCacheInvalidationListener(msg,
+			func(key *serialization.Data, sourceUUID types.UUID, partitionUUID types.UUID, sequence int64) {
+				handler.Handle(key, sourceUUID, partitionUUID, sequence)
+			},
+			func(keys []*serialization.Data, sourceUUIDs []types.UUID, partitionUUIDs []types.UUID, sequences []int64) {
+				handler.HandleBatch(keys, sourceUUIDs, partitionUUIDs, sequences)
+			})
+	}
+	if err := ncMap.addListener(ctx, addRequest, removeRequest, listenerHandler); err != nil {
+		ncm.DestroyNearCache(p.serviceName, p.name)
+		return nil, err
+	}
+	return ncMap, nil
+}
+
+func newNearCacheReplicatedMap(ctx context.Context, p *proxy, ncm *inearcache.Manager, cfg nearcache.Config) (*nearCacheMap, error) {
+	nc := ncm.GetOrCreateNonRepairingNearCache(p.serviceName, p.name, cfg)
+	ncMap := &nearCacheMap{
+		nc:             nc,
+		ncm:            ncm,
+		p:              p,
+		subscriptionID: types.NewUUID(),
+	}
+	addRequest := codec.EncodeReplicatedMapAddNearCacheEntryListenerRequest(p.name, false, p.smart)
+	removeRequest := codec.EncodeReplicatedMapRemoveEntryListenerRequest(p.name, ncMap.subscriptionID)
+	listenerHandler := func(msg *proto.ClientMessage) {
+		codec.HandleReplicatedMapAddNearCacheEntryListener(msg, func(key, value, oldValue, mergingValue *serialization.Data, eventType int32, uuid types.UUID, affected int32) {
+			if key == nil || EntryEventType(eventType) == EntryAllCleared {
+				nc.Clear()
+				return
+			}
+			nc.Invalidate(key)
+		})
+	}
+	if err := ncMap.addListener(ctx, addRequest, removeRequest, listenerHandler); err != nil {
+		ncm.DestroyNearCache(p.serviceName, p.name)
+		return nil, err
+	}
+	return ncMap, nil
+}
+
+// Get returns the value for the given key from the Near Cache.
+// If the value is not cached, it is fetched with fetchFn and cached if possible.
+func (n *nearCacheMap) Get(keyData *serialization.Data, fetchFn func() (*serialization.Data, error)) (interface{}, error) {
+	if value, ok, err := n.nc.Get(keyData); err != nil {
+		return nil, err
+	} else if ok {
+		return value, nil
+	}
+	reservationID, reserved := n.nc.TryReserveForUpdate(keyData)
+	valueData, err := fetchFn()
+	if err != nil {
+		if reserved {
+			n.nc.CancelReservation(keyData, reservationID)
+		}
+		return nil, err
+	}
+	if !reserved {
+		return n.p.convertToObject(valueData)
+	}
+	return n.nc.TryPublishReserved(keyData, valueData, reservationID)
+}
+
+// GetAll returns the entries for the given keys, using the Near Cache for the cached ones.
+// The missing entries are fetched with fetchFn and cached if possible.
+func (n *nearCacheMap) GetAll(keys []*serialization.Data, fetchFn func(keys []*serialization.Data) ([]proto.Pair, error)) ([]types.Entry, error) {
+	result := make([]types.Entry, 0, len(keys))
+	missing := make([]*serialization.Data, 0, len(keys))
+	for _, keyData := range keys {
+		value, ok, err := n.nc.Get(keyData)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			missing = append(missing, keyData)
+			continue
+		}
+		key, err := n.p.convertToObject(keyData)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, types.NewEntry(key, value))
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+	reservations := make(map[string]int64, len(missing))
+	for _, keyData := range missing {
+		if id, ok := n.nc.TryReserveForUpdate(keyData); ok {
+			reservations[string(keyData.ToByteArray())] = id
+		}
+	}
+	pairs, err := fetchFn(missing)
+	if err != nil {
+		for _, keyData := range missing {
+			if id, ok := reservations[string(keyData.ToByteArray())]; ok {
+				n.nc.CancelReservation(keyData, id)
+			}
+		}
+		return nil, err
+	}
+	for _, pair := range pairs {
+		keyData := pair.Key().(*serialization.Data)
+		valueData := pair.Value().(*serialization.Data)
+		var value interface{}
+		k := string(keyData.ToByteArray())
+		if id, ok := reservations[k]; ok {
+			delete(reservations, k)
+			value, err = n.nc.TryPublishReserved(keyData, valueData, id)
+		} else {
+			value, err = n.p.convertToObject(valueData)
+		}
+		if err != nil {
+			return nil, err
+		}
+		key, err := n.p.convertToObject(keyData)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, types.NewEntry(key, value))
+	}
+	// the keys which do not exist in the map are not returned, release their reservations
+	for _, keyData := range missing {
+		if id, ok := reservations[string(keyData.ToByteArray())]; ok {
+			n.nc.CancelReservation(keyData, id)
+		}
+	}
+	return result, nil
+}
+
+// Invalidate removes the given key from the Near Cache.
+func (n *nearCacheMap) Invalidate(keyData *serialization.Data) {
+	n.nc.Invalidate(keyData)
+}
+
+// Clear removes all entries from the Near Cache.
+func (n *nearCacheMap) Clear() {
+	n.nc.Clear()
+}
+
+// Destroy removes the invalidation listener and the Near Cache.
+func (n *nearCacheMap) Destroy(ctx context.Context) error {
+	n.ncm.DestroyNearCache(n.p.serviceName, n.p.name)
+	return n.p.listenerBinder.Remove(ctx, n.subscriptionID)
+}
+
+func (n *nearCacheMap) addListener(ctx context.Context, addRequest, removeRequest *proto.ClientMessage, handler proto.ClientMessageHandler) error {
+	return n.p.listenerBinder.Add(ctx, n.subscriptionID, addRequest, removeRequest, handler)
+}
+
+// invokeGetAll fetches the entries for the given keys from their partition owners.
+func (m *Map) invokeGetAll(ctx context.Context, keys []*serialization.Data) ([]proto.Pair, error) {
+	partitionToKeys := map[int32][]*serialization.Data{}
+	for _, keyData := range keys {
+		partitionID, err := m.partitionService.GetPartitionID(keyData)
+		if err != nil {
+			return nil, err
+		}
+		partitionToKeys[partitionID] = append(partitionToKeys[partitionID], keyData)
+	}
+	f := func(partitionID int32, keys []*serialization.Data) cb.Future {
+		request := codec.EncodeMapGetAllRequest(m.name, keys)
+		return m.cb.TryContextFuture(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+			if attempt > 0 {
+				request = request.Copy()
+			}
+			return m.invokeOnPartition(ctx, request, partitionID)
+		})
+	}
+	futures := make([]cb.Future, 0, len(partitionToKeys))
+	for partitionID, keys := range partitionToKeys {
+		futures = append(futures, f(partitionID, keys))
+	}
+	result := make([]proto.Pair, 0, len(keys))
+	for _, future := range futures {
+		resp, err := future.Result()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, codec.DecodeMapGetAllResponse(resp.(*proto.ClientMessage))...)
+	}
+	return result, nil
+}
+
+func (m *Map) invalidateNearCache(keyData *serialization.Data) {
+	if m.ncm != nil {
+		m.ncm.Invalidate(keyData)
+	}
+}
+
+func (m *Map) clearNearCache() {
+	if m.ncm != nil {
+		m.ncm.Clear()
+	}
+}
@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// iteration types determine how the paging predicate results are sorted when there is no comparator.
+const (
+	iterationTypeKey byte = iota
+	iterationTypeValue
+	iterationTypeEntry
+)
+
+func (m *Map) getKeySetWithPagingPredicate(ctx context.Context, pred *predicate.PagingPredicate, partitionKey interface{}) ([]interface{}, error) {
+	holder, err := m.makePagingPredicateHolder(pred, iterationTypeKey, partitionKey)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapKeySetWithPagingPredicateRequest(m.name, holder)
+	response, err := m.invokeWithPagingPredicate(ctx, request, holder.PartitionKeyData)
+	if err != nil {
+		return nil, err
+	}
+	keyDatas, anchors := codec.DecodeMapKeySetWithPagingPredicateResponse(response)
+	if err := m.updateAnchors(pred, anchors); err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(keyDatas)
+}
+
+func (m *Map) getValuesWithPagingPredicate(ctx context.Context, pred *predicate.PagingPredicate, partitionKey interface{}) ([]interface{}, error) {
+	holder, err := m.makePagingPredicateHolder(pred, iterationTypeValue, partitionKey)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapValuesWithPagingPredicateRequest(m.name, holder)
+	response, err := m.invokeWithPagingPredicate(ctx, request, holder.PartitionKeyData)
+	if err != nil {
+		return nil, err
+	}
+	valueDatas, anchors := codec.DecodeMapValuesWithPagingPredicateResponse(response)
+	if err := m.updateAnchors(pred, anchors); err != nil {
+		return nil, err
+	}
+	return m.convertToObjects(valueDatas)
+}
+
+func (m *Map) getEntrySetWithPagingPredicate(ctx context.Context, pred *predicate.PagingPredicate, partitionKey interface{}) ([]types.Entry, error) {
+	holder, err := m.makePagingPredicateHolder(pred, iterationTypeEntry, partitionKey)
+	if err != nil {
+		return nil, err
+	}
+	request := codec.EncodeMapEntriesWithPagingPredicateRequest(m.name, holder)
+	response, err := m.invokeWithPagingPredicate(ctx, request, holder.PartitionKeyData)
+	if err != nil {
+		return nil, err
+	}
+	pairs, anchors := codec.DecodeMapEntriesWithPagingPredicateResponse(response)
+	if err := m.updateAnchors(pred, anchors); err != nil {
+		return nil, err
+	}
+	return m.convertPairsToEntries(pairs)
+}
+
+func (m *Map) makePagingPredicateHolder(pred *predicate.PagingPredicate, iterationType byte, partitionKey interface{}) (codec.PagingPredicateHolder, error) {
+	var holder codec.PagingPredicateHolder
+	if pred.PageSize() <= 0 {
+		return holder, ihzerrors.NewIllegalArgumentError("page size must be positive", nil)
+	}
+	if _, ok := pred.Predicate().(*predicate.PagingPredicate); ok {
+		return holder, ihzerrors.NewIllegalArgumentError("nested paging predicates are not supported", nil)
+	}
+	var predData, compData, partitionKeyData *serialization.Data
+	var err error
+	if partitionKey != nil {
+		if partitionKeyData, err = m.validateAndSerialize(partitionKey); err != nil {
+			return holder, err
+		}
+	}
+	if pred.Predicate() != nil {
+		if predData, err = m.serializationService.ToData(pred.Predicate()); err != nil {
+			return holder, err
+		}
+	}
+	if pred.Comparator() != nil {
+		if compData, err = m.serializationService.ToData(pred.Comparator()); err != nil {
+			return holder, err
+		}
+	}
+	anchors := pred.AnchorList()
+	pages := make([]int32, len(anchors))
+	pairs := make([]proto.Pair, len(anchors))
+	for i, anchor := range anchors {
+		keyData, valueData, err := m.validateAndSerialize2(anchor.Entry.Key, anchor.Entry.Value)
+		if err != nil {
+			return holder, err
+		}
+		pages[i] = int32(anchor.Page)
+		pairs[i] = proto.NewPair(keyData, valueData)
+	}
+	anchorHolder := codec.NewAnchorDataListHolder(pages, pairs)
+	return codec.NewPagingPredicateHolder(anchorHolder, predData, compData, int32(pred.PageSize()), int32(pred.Page()), iterationType, partitionKeyData), nil
+}
+
+func (m *Map) invokeWithPagingPredicate(ctx context.Context, request *proto.ClientMessage, partitionKeyData *serialization.Data) (*proto.ClientMessage, error) {
+	if partitionKeyData != nil {
+		return m.invokeOnKey(ctx, request, partitionKeyData)
+	}
+	return m.invokeOnRandomTarget(ctx, request, nil)
+}
+
+func (m *Map) updateAnchors(pred *predicate.PagingPredicate, holder codec.AnchorDataListHolder) error {
+	anchors := make([]predicate.PagingAnchor, len(holder.AnchorDataList))
+	for i, pair := range holder.AnchorDataList {
+		key, err := m.convertToObject(pair.Key().(*serialization.Data))
+		if err != nil {
+			return err
+		}
+		value, err := m.convertToObject(pair.Value().(*serialization.Data))
+		if err != nil {
+			return err
+		}
+		anchors[i] = predicate.PagingAnchor{
+			Page:  int(holder.AnchorPageList[i]),
+			Entry: types.NewEntry(key, value),
+		}
+	}
+	pred.SetAnchorList(anchors)
+	return nil
+}
+
+// invokeWithPredicate sends the query only to the owner of the partition if the predicate is a partition predicate.
+// Otherwise, the query is sent to a random member, which runs it on all members.
+func (m *Map) invokeWithPredicate(ctx context.Context, request *proto.ClientMessage, pred predicate.Predicate) (*proto.ClientMessage, error) {
+	pp, ok := pred.(*predicate.PartitionPredicate)
+	if !ok {
+		return m.invokeOnRandomTarget(ctx, request, nil)
+	}
+	partitionKeyData, err := m.validateAndSerialize(pp.PartitionKey())
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := m.partitionService.GetPartitionID(partitionKeyData)
+	if err != nil {
+		return nil, err
+	}
+	return m.invokeOnPartition(ctx, request, partitionID)
+}
+
+// extractPagingPredicate returns the paging predicate and the partition key if the given predicate is a paging predicate
+// or a partition predicate which contains a paging predicate.
+func extractPagingPredicate(pred predicate.Predicate) (*predicate.PagingPredicate, interface{}, bool) {
+	switch p := pred.(type) {
+	case *predicate.PagingPredicate:
+		return p, nil, true
+	case *predicate.PartitionPredicate:
+		if pp, ok := p.Predicate().(*predicate.PagingPredicate); ok {
+			return pp, p.PartitionKey(), true
+		}
+	}
+	return nil, nil, false
+}
+
+func checkNotPagingPredicate(pred predicate.Predicate, method string) error {
+	if _, _, ok := extractPagingPredicate(pred); ok {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("paging predicate is not supported in %s", method), nil)
+	}
+	return nil
+}
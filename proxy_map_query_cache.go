@@ -0,0 +1,583 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	queryCacheBatchSize    = 1
+	queryCacheBufferSize   = 16
+	queryCacheDelaySeconds = 0
+)
+
+// QueryCacheFilter is a predicate which is evaluated locally on the entries of a QueryCache.
+type QueryCacheFilter func(key, value interface{}) bool
+
+/*
+QueryCache is a continuously updated, local view of the entries of a Map which match a predicate.
+
+The entries are sent to the client when the query cache is created,
+and afterwards the members send the events of the matching entries to keep the query cache up to date.
+So, reading from a query cache does not require a round trip to the cluster.
+The read functions have the same signatures as the corresponding Map functions, so a QueryCache can be used in place of a Map for reading.
+Note that the query cache may lag behind the map, since the events are delivered asynchronously.
+
+The events of each partition are numbered by the members.
+If some events are lost, such as while the client is reconnecting to the cluster,
+the query cache asks the members to send the events again starting from the first lost one.
+If those events are not available anymore, the query cache is populated from scratch.
+
+The predicate of the query cache is evaluated on the members.
+The entries of the query cache can be further filtered on the client using a QueryCacheFilter:
+
+	qc, err := m.GetQueryCache(ctx, "open-orders", predicate.Equal("status", "OPEN"), true)
+	urgent, err := qc.GetValuesWithFilter(ctx, func(key, value interface{}) bool {
+		return value.(Order).Urgent
+	})
+
+For details see https://docs.hazelcast.com/imdg/latest/data-structures/query-cache.html
+*/
+type QueryCache struct {
+	m              *Map
+	predicateData  *iserialization.Data
+	mu             *sync.RWMutex
+	records        map[string]queryCacheRecord
+	sequences      map[int32]int64
+	gaps           map[int32]struct{}
+	listenersMu    *sync.RWMutex
+	listeners      map[types.UUID]EntryNotifiedHandler
+	pendingEvents  []codec.QueryCacheEventData
+	name           string
+	cacheID        string
+	pendingCacheID string
+	subscriptionID types.UUID
+	includeValue   bool
+	recovering     int32
+	destroyed      int32
+}
+
+type queryCacheRecord struct {
+	key         interface{}
+	value       interface{}
+	partitionID int32
+}
+
+func newQueryCache(m *Map, name string, predicateData *iserialization.Data, includeValue bool) *QueryCache {
+	return &QueryCache{
+		m:             m,
+		predicateData: predicateData,
+		mu:            &sync.RWMutex{},
+		records:       map[string]queryCacheRecord{},
+		sequences:     map[int32]int64{},
+		gaps:          map[int32]struct{}{},
+		listenersMu:   &sync.RWMutex{},
+		listeners:     map[types.UUID]EntryNotifiedHandler{},
+		name:          name,
+		includeValue:  includeValue,
+	}
+}
+
+// Name returns the name of this query cache.
+func (qc *QueryCache) Name() string {
+	return qc.name
+}
+
+// AddEntryListener adds a listener which is notified when an entry of this query cache is added, updated or removed.
+// The listener is local to this query cache, adding it does not require a round trip to the cluster.
+func (qc *QueryCache) AddEntryListener(ctx context.Context, handler EntryNotifiedHandler) (types.UUID, error) {
+	if handler == nil {
+		return types.UUID{}, ihzerrors.NewIllegalArgumentError("handler cannot be nil", nil)
+	}
+	subscriptionID := types.NewUUID()
+	qc.listenersMu.Lock()
+	qc.listeners[subscriptionID] = handler
+	qc.listenersMu.Unlock()
+	return subscriptionID, nil
+}
+
+// ContainsKey returns true if this query cache contains an entry with the given key.
+func (qc *QueryCache) ContainsKey(ctx context.Context, key interface{}) (bool, error) {
+	keyData, err := qc.m.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	qc.mu.RLock()
+	defer qc.mu.RUnlock()
+	_, ok := qc.records[recordKey(keyData)]
+	return ok, nil
+}
+
+// Destroy removes this query cache from the members and releases its local resources.
+// The backing map is not affected.
+func (qc *QueryCache) Destroy(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&qc.destroyed, 0, 1) {
+		return nil
+	}
+	qc.m.removeQueryCache(qc)
+	qc.listenersMu.Lock()
+	qc.listeners = map[types.UUID]EntryNotifiedHandler{}
+	qc.listenersMu.Unlock()
+	qc.mu.Lock()
+	cacheID, subscriptionID := qc.cacheID, qc.subscriptionID
+	qc.cacheID = ""
+	qc.pendingCacheID = ""
+	qc.pendingEvents = nil
+	qc.records = map[string]queryCacheRecord{}
+	qc.mu.Unlock()
+	return qc.unsubscribe(ctx, cacheID, subscriptionID)
+}
+
+// Get returns the value for the given key, or nil if this query cache does not contain the key.
+// The value is always nil if the query cache was created without including values.
+func (qc *QueryCache) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	keyData, err := qc.m.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	qc.mu.RLock()
+	defer qc.mu.RUnlock()
+	return qc.records[recordKey(keyData)].value, nil
+}
+
+// GetEntrySet returns a clone of the entries in this query cache.
+func (qc *QueryCache) GetEntrySet(ctx context.Context) ([]types.Entry, error) {
+	return qc.GetEntrySetWithFilter(ctx, nil)
+}
+
+// GetEntrySetWithFilter returns a clone of the entries in this query cache which satisfy the given filter.
+func (qc *QueryCache) GetEntrySetWithFilter(ctx context.Context, filter QueryCacheFilter) ([]types.Entry, error) {
+	var entries []types.Entry
+	qc.scan(filter, func(r queryCacheRecord) {
+		entries = append(entries, types.NewEntry(r.key, r.value))
+	})
+	return entries, nil
+}
+
+// GetKeySet returns the keys in this query cache.
+func (qc *QueryCache) GetKeySet(ctx context.Context) ([]interface{}, error) {
+	return qc.GetKeySetWithFilter(ctx, nil)
+}
+
+// GetKeySetWithFilter returns the keys of the entries in this query cache which satisfy the given filter.
+func (qc *QueryCache) GetKeySetWithFilter(ctx context.Context, filter QueryCacheFilter) ([]interface{}, error) {
+	var keys []interface{}
+	qc.scan(filter, func(r queryCacheRecord) {
+		keys = append(keys, r.key)
+	})
+	return keys, nil
+}
+
+// GetValues returns the values in this query cache.
+func (qc *QueryCache) GetValues(ctx context.Context) ([]interface{}, error) {
+	return qc.GetValuesWithFilter(ctx, nil)
+}
+
+// GetValuesWithFilter returns the values of the entries in this query cache which satisfy the given filter.
+func (qc *QueryCache) GetValuesWithFilter(ctx context.Context, filter QueryCacheFilter) ([]interface{}, error) {
+	var values []interface{}
+	qc.scan(filter, func(r queryCacheRecord) {
+		values = append(values, r.value)
+	})
+	return values, nil
+}
+
+// IsEmpty returns true if this query cache does not contain any entries.
+func (qc *QueryCache) IsEmpty(ctx context.Context) (bool, error) {
+	size, err := qc.Size(ctx)
+	return size == 0, err
+}
+
+// RemoveEntryListener removes the given entry listener.
+func (qc *QueryCache) RemoveEntryListener(ctx context.Context, subscriptionID types.UUID) error {
+	qc.listenersMu.Lock()
+	delete(qc.listeners, subscriptionID)
+	qc.listenersMu.Unlock()
+	return nil
+}
+
+// Size returns the number of entries in this query cache.
+func (qc *QueryCache) Size(ctx context.Context) (int, error) {
+	qc.mu.RLock()
+	defer qc.mu.RUnlock()
+	return len(qc.records), nil
+}
+
+// TryRecover asks the members to send the lost events again, starting from the first lost event of each partition.
+// If the lost events are not available anymore, this query cache is populated from scratch.
+// Recovery is started automatically once lost events are detected, so calling this function is usually not necessary.
+func (qc *QueryCache) TryRecover(ctx context.Context) error {
+	qc.mu.RLock()
+	cacheID := qc.cacheID
+	cursors := make(map[int32]int64, len(qc.gaps))
+	for partitionID := range qc.gaps {
+		cursors[partitionID] = qc.sequences[partitionID] + 1
+	}
+	qc.mu.RUnlock()
+	for partitionID, sequence := range cursors {
+		request := codec.EncodeContinuousQuerySetReadCursorRequest(qc.m.name, cacheID, sequence)
+		response, err := qc.m.invokeOnPartition(ctx, request, partitionID)
+		if err != nil {
+			return err
+		}
+		if !codec.DecodeContinuousQuerySetReadCursorResponse(response) {
+			qc.m.logger.Debug(func() string {
+				return "query cache " + qc.name + " lost events which are not available anymore, populating it again"
+			})
+			return qc.repopulate(ctx)
+		}
+	}
+	return nil
+}
+
+// subscribe creates a publisher on the members which sends the events of the entries that match the predicate.
+// It returns the initial entries of the query cache and the identifiers to remove the publisher.
+func (qc *QueryCache) subscribe(ctx context.Context) (map[string]queryCacheRecord, string, types.UUID, error) {
+	cacheID := types.NewUUID().String()
+	subscriptionID := types.NewUUID()
+	addRequest := codec.EncodeContinuousQueryAddListenerRequest(cacheID, qc.m.smart)
+	removeRequest := codec.EncodeMapRemoveEntryListenerRequest(cacheID, subscriptionID)
+	listenerHandler := func(msg *proto.ClientMessage) {
+		codec.HandleContinuousQueryAddListener(msg, func(data codec.QueryCacheEventData) {
+			qc.handleEvents(cacheID, []codec.QueryCacheEventData{data})
+		}, func(events []codec.QueryCacheEventData, source string, partitionID int32) {
+			qc.handleEvents(cacheID, events)
+		})
+	}
+	// the events which arrive before the initial entries are installed are buffered, see install
+	qc.mu.Lock()
+	qc.pendingCacheID = cacheID
+	qc.pendingEvents = nil
+	qc.mu.Unlock()
+	if err := qc.m.listenerBinder.Add(ctx, subscriptionID, addRequest, removeRequest, listenerHandler); err != nil {
+		qc.discardPendingEvents(cacheID)
+		return nil, "", types.UUID{}, err
+	}
+	records, err := qc.createPublisher(ctx, cacheID)
+	if err != nil {
+		qc.discardPendingEvents(cacheID)
+		if err := qc.unsubscribe(ctx, cacheID, subscriptionID); err != nil {
+			qc.m.logger.Debug(func() string { return "removing query cache publisher: " + err.Error() })
+		}
+		return nil, "", types.UUID{}, err
+	}
+	return records, cacheID, subscriptionID, nil
+}
+
+// createPublisher creates the publisher and returns the entries which match the predicate at the time of creation.
+// The members start sending events only after the publisher is made publishable, so no events are missed.
+func (qc *QueryCache) createPublisher(ctx context.Context, cacheID string) (map[string]queryCacheRecord, error) {
+	records := map[string]queryCacheRecord{}
+	if qc.includeValue {
+		request := codec.EncodeContinuousQueryPublisherCreateWithValueRequest(qc.m.name, cacheID, qc.predicateData,
+			queryCacheBatchSize, queryCacheBufferSize, queryCacheDelaySeconds, true, false)
+		response, err := qc.m.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range codec.DecodeContinuousQueryPublisherCreateWithValueResponse(response) {
+			keyData := pair.Key().(*iserialization.Data)
+			partitionID, err := qc.m.partitionService.GetPartitionID(keyData)
+			if err != nil {
+				return nil, err
+			}
+			record, err := qc.makeRecord(keyData, pair.Value().(*iserialization.Data), partitionID)
+			if err != nil {
+				return nil, err
+			}
+			records[recordKey(keyData)] = record
+		}
+	} else {
+		request := codec.EncodeContinuousQueryPublisherCreateRequest(qc.m.name, cacheID, qc.predicateData,
+			queryCacheBatchSize, queryCacheBufferSize, queryCacheDelaySeconds, true, false)
+		response, err := qc.m.invokeOnRandomTarget(ctx, request, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, keyData := range codec.DecodeContinuousQueryPublisherCreateResponse(response) {
+			partitionID, err := qc.m.partitionService.GetPartitionID(keyData)
+			if err != nil {
+				return nil, err
+			}
+			record, err := qc.makeRecord(keyData, nil, partitionID)
+			if err != nil {
+				return nil, err
+			}
+			records[recordKey(keyData)] = record
+		}
+	}
+	request := codec.EncodeContinuousQueryMadePublishableRequest(qc.m.name, cacheID)
+	if _, err := qc.m.invokeOnRandomTarget(ctx, request, nil); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// unsubscribe removes the publisher with the given identifiers from the members.
+func (qc *QueryCache) unsubscribe(ctx context.Context, cacheID string, subscriptionID types.UUID) error {
+	if err := qc.m.listenerBinder.Remove(ctx, subscriptionID); err != nil {
+		return err
+	}
+	request := codec.EncodeContinuousQueryDestroyCacheRequest(qc.m.name, cacheID)
+	_, err := qc.m.invokeOnRandomTarget(ctx, request, nil)
+	return err
+}
+
+// start creates the publisher and populates this query cache.
+func (qc *QueryCache) start(ctx context.Context) error {
+	records, cacheID, subscriptionID, err := qc.subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	qc.mu.Lock()
+	notifications, lost := qc.install(records, cacheID, subscriptionID)
+	qc.mu.Unlock()
+	qc.dispatch(notifications, lost)
+	return nil
+}
+
+// repopulate replaces the publisher with a new one and populates this query cache from scratch.
+// The listeners are not notified about the entries which changed while the events were lost.
+func (qc *QueryCache) repopulate(ctx context.Context) error {
+	records, cacheID, subscriptionID, err := qc.subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	qc.mu.Lock()
+	if atomic.LoadInt32(&qc.destroyed) == 1 {
+		qc.mu.Unlock()
+		qc.discardPendingEvents(cacheID)
+		return qc.unsubscribe(ctx, cacheID, subscriptionID)
+	}
+	oldCacheID, oldSubscriptionID := qc.cacheID, qc.subscriptionID
+	notifications, lost := qc.install(records, cacheID, subscriptionID)
+	qc.mu.Unlock()
+	qc.dispatch(notifications, lost)
+	return qc.unsubscribe(ctx, oldCacheID, oldSubscriptionID)
+}
+
+// install replaces the records with the initial entries of the publisher with the given cache ID,
+// then applies the events of that publisher which were buffered while it was being created.
+// It returns the notifications of the applied events and whether lost events were detected.
+// Must be called while holding the write lock.
+func (qc *QueryCache) install(records map[string]queryCacheRecord, cacheID string, subscriptionID types.UUID) ([]*EntryNotified, bool) {
+	qc.records = records
+	qc.cacheID = cacheID
+	qc.subscriptionID = subscriptionID
+	qc.sequences = map[int32]int64{}
+	qc.gaps = map[int32]struct{}{}
+	if qc.pendingCacheID != cacheID {
+		return nil, false
+	}
+	events := qc.pendingEvents
+	qc.pendingCacheID = ""
+	qc.pendingEvents = nil
+	return qc.applyEvents(events)
+}
+
+// discardPendingEvents drops the buffered events of the publisher with the given cache ID.
+func (qc *QueryCache) discardPendingEvents(cacheID string) {
+	qc.mu.Lock()
+	if qc.pendingCacheID == cacheID {
+		qc.pendingCacheID = ""
+		qc.pendingEvents = nil
+	}
+	qc.mu.Unlock()
+}
+
+// recover runs TryRecover in the background, unless it is already running.
+func (qc *QueryCache) recover() {
+	if !atomic.CompareAndSwapInt32(&qc.recovering, 0, 1) {
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&qc.recovering, 0)
+		if err := qc.TryRecover(context.Background()); err != nil {
+			qc.m.logger.Warnf("query cache %s could not recover lost events: %s", qc.name, err.Error())
+		}
+	}()
+}
+
+func (qc *QueryCache) handleEvents(cacheID string, events []codec.QueryCacheEventData) {
+	qc.mu.Lock()
+	if cacheID == qc.pendingCacheID {
+		// the publisher is being created, the events are applied once its initial entries are installed
+		qc.pendingEvents = append(qc.pendingEvents, events...)
+		qc.mu.Unlock()
+		return
+	}
+	if cacheID != qc.cacheID {
+		// the event belongs to a removed publisher
+		qc.mu.Unlock()
+		return
+	}
+	notifications, lost := qc.applyEvents(events)
+	qc.mu.Unlock()
+	qc.dispatch(notifications, lost)
+}
+
+// applyEvents applies the events in sequence order of their partitions.
+// It returns the notifications to send to the listeners and whether lost events were detected.
+// Must be called while holding the write lock.
+func (qc *QueryCache) applyEvents(events []codec.QueryCacheEventData) ([]*EntryNotified, bool) {
+	var notifications []*EntryNotified
+	lost := false
+	for _, event := range events {
+		sequence := qc.sequences[event.PartitionId]
+		if event.Sequence <= sequence {
+			// the event was already applied, it is sent again during recovery
+			continue
+		}
+		if event.Sequence > sequence+1 {
+			// some events of the partition were lost, the events are ignored until they are sent again
+			qc.gaps[event.PartitionId] = struct{}{}
+			lost = true
+			continue
+		}
+		qc.sequences[event.PartitionId] = event.Sequence
+		delete(qc.gaps, event.PartitionId)
+		notified, err := qc.apply(event)
+		if err != nil {
+			qc.m.logger.Errorf("error applying query cache event: %w", err)
+			continue
+		}
+		if notified != nil {
+			notifications = append(notifications, notified)
+		}
+	}
+	return notifications, lost
+}
+
+// dispatch starts the recovery if lost events were detected and sends the notifications to the listeners.
+// Must be called without holding the lock, so the listeners may use this query cache.
+func (qc *QueryCache) dispatch(notifications []*EntryNotified, lost bool) {
+	if lost {
+		qc.recover()
+	}
+	if len(notifications) == 0 {
+		return
+	}
+	qc.listenersMu.RLock()
+	handlers := make([]EntryNotifiedHandler, 0, len(qc.listeners))
+	for _, handler := range qc.listeners {
+		handlers = append(handlers, handler)
+	}
+	qc.listenersMu.RUnlock()
+	for _, notified := range notifications {
+		for _, handler := range handlers {
+			handler(notified)
+		}
+	}
+}
+
+// apply updates the records using the given event.
+// It returns the notification to send to the listeners, or nil if the records did not change.
+// Must be called while holding the write lock.
+func (qc *QueryCache) apply(event codec.QueryCacheEventData) (*EntryNotified, error) {
+	eventType := EntryEventType(event.EventType)
+	switch eventType {
+	case EntryAllCleared, EntryAllEvicted:
+		// the members send a separate event for each partition
+		affected := 0
+		for key, record := range qc.records {
+			if record.partitionID == event.PartitionId {
+				delete(qc.records, key)
+				affected++
+			}
+		}
+		if affected == 0 {
+			return nil, nil
+		}
+		return qc.newEntryNotified(nil, nil, nil, affected, eventType), nil
+	case EntryRemoved, EntryEvicted, EntryExpired:
+		key := recordKey(event.DataKey)
+		old, ok := qc.records[key]
+		if !ok {
+			return nil, nil
+		}
+		delete(qc.records, key)
+		return qc.newEntryNotified(old.key, nil, old.value, 1, eventType), nil
+	default:
+		record, err := qc.makeRecord(event.DataKey, event.DataNewValue, event.PartitionId)
+		if err != nil {
+			return nil, err
+		}
+		key := recordKey(event.DataKey)
+		old, ok := qc.records[key]
+		qc.records[key] = record
+		if ok {
+			return qc.newEntryNotified(record.key, record.value, old.value, 1, EntryUpdated), nil
+		}
+		return qc.newEntryNotified(record.key, record.value, nil, 1, EntryAdded), nil
+	}
+}
+
+func (qc *QueryCache) newEntryNotified(key, value, oldValue interface{}, affected int, eventType EntryEventType) *EntryNotified {
+	return newEntryNotifiedEvent(qc.m.name, pubcluster.MemberInfo{}, key, value, oldValue, nil, affected, eventType)
+}
+
+func (qc *QueryCache) makeRecord(keyData, valueData *iserialization.Data, partitionID int32) (queryCacheRecord, error) {
+	key, err := qc.m.convertToObject(keyData)
+	if err != nil {
+		return queryCacheRecord{}, err
+	}
+	var value interface{}
+	if qc.includeValue && valueData != nil {
+		if value, err = qc.m.convertToObject(valueData); err != nil {
+			return queryCacheRecord{}, err
+		}
+	}
+	return queryCacheRecord{key: key, value: value, partitionID: partitionID}, nil
+}
+
+// scan calls f with the records which satisfy the given filter.
+// The filter is called without holding the lock, so it may use this query cache.
+func (qc *QueryCache) scan(filter QueryCacheFilter, f func(r queryCacheRecord)) {
+	qc.mu.RLock()
+	records := make([]queryCacheRecord, 0, len(qc.records))
+	for _, r := range qc.records {
+		records = append(records, r)
+	}
+	qc.mu.RUnlock()
+	for _, r := range records {
+		if filter == nil || filter(r.key, r.value) {
+			f(r)
+		}
+	}
+}
+
+// recordKey returns the key of the record for the given serialized map key.
+func recordKey(keyData *iserialization.Data) string {
+	return string(keyData.ToByteArray())
+}
+
+func checkQueryCacheName(name string) error {
+	if name == "" {
+		return ihzerrors.NewIllegalArgumentError("query cache name cannot be blank", nil)
+	}
+	return nil
+}
@@ -140,6 +140,21 @@ func (m *MultiMap) ContainsKey(ctx context.Context, key interface{}) (bool, erro
 	}
 }
 
+// ContainsEntry returns true if the multi-map contains the given value under the given key.
+func (m *MultiMap) ContainsEntry(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	lid := extractLockID(ctx)
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeMultiMapContainsEntryRequest(m.name, keyData, valueData, lid)
+		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeMultiMapContainsEntryResponse(response), nil
+		}
+	}
+}
+
 // ContainsValue returns true if the map contains an entry with the given value.
 func (m *MultiMap) ContainsValue(ctx context.Context, value interface{}) (bool, error) {
 	if valueData, err := m.validateAndSerialize(value); err != nil {
@@ -318,6 +333,22 @@ func (m *MultiMap) Remove(ctx context.Context, key interface{}) ([]interface{},
 	}
 }
 
+// RemoveEntry removes the given value under the given key, keeping the other values of the key.
+// Returns true if the value was removed.
+func (m *MultiMap) RemoveEntry(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	lid := extractLockID(ctx)
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeMultiMapRemoveEntryRequest(m.name, keyData, valueData, lid)
+		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeMultiMapRemoveEntryResponse(response), nil
+		}
+	}
+}
+
 // RemoveEntryListener removes the specified entry listener.
 func (m *MultiMap) RemoveEntryListener(ctx context.Context, subscriptionID types.UUID) error {
 	return m.listenerBinder.Remove(ctx, subscriptionID)
@@ -371,6 +402,21 @@ func (m *MultiMap) Unlock(ctx context.Context, key interface{}) error {
 	}
 }
 
+// ValueCount returns the number of values under the given key.
+func (m *MultiMap) ValueCount(ctx context.Context, key interface{}) (int, error) {
+	lid := extractLockID(ctx)
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return 0, err
+	} else {
+		request := codec.EncodeMultiMapValueCountRequest(m.name, keyData, lid)
+		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
+			return 0, err
+		} else {
+			return int(codec.DecodeMultiMapValueCountResponse(response)), nil
+		}
+	}
+}
+
 func (m *MultiMap) addEntryListener(ctx context.Context, includeValue, localOnly bool, key interface{}, handler EntryNotifiedHandler) (types.UUID, error) {
 	var err error
 	var keyData *serialization.Data
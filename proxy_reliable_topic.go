@@ -0,0 +1,366 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pubcluster "github.com/hazelcast/hazelcast-go-client/cluster"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	reliableTopicRingbufferPrefix = "_hz_rb_"
+	defaultReliableTopicBatchSize = 10
+	reliableTopicLatestSequence   = -1
+	reliableTopicMinBackoff       = 100 * time.Millisecond
+	reliableTopicMaxBackoff       = 2 * time.Second
+)
+
+/*
+ReliableTopic is a Topic backed by a Ringbuffer.
+
+Published messages are stored in a ringbuffer named after the topic, and each message listener reads the messages from
+that ringbuffer, keeping track of the sequence of the next message to read.
+So, unlike Topic, messages published while a listener is disconnected are not lost, as long as they are not
+overwritten in the ringbuffer in the mean time.
+Listeners keep reading from the same sequence after member failures or client reconnections,
+so messages are neither lost nor delivered more than once.
+
+The capacity and time to live of the backing ringbuffer are configured on the member side.
+
+For details see https://docs.hazelcast.com/imdg/latest/data-structures/reliable-topic.html
+*/
+type ReliableTopic struct {
+	*proxy
+	rb      *Ringbuffer
+	mu      *sync.Mutex
+	runners map[types.UUID]*reliableMessageRunner
+}
+
+// ReliableMessageListenerConfig contains the options for a ReliableTopic message listener.
+// Use NewReliableMessageListenerConfig to create a configuration with the default values.
+type ReliableMessageListenerConfig struct {
+	// InitialSequence is the sequence of the first message the listener receives.
+	// If it is negative, the listener receives only the messages published after it is added.
+	// Defaults to -1.
+	InitialSequence int64
+	// BatchSize is the maximum number of messages read from the ringbuffer at once.
+	// The allowed range is [1, 1000] and defaults to 10.
+	BatchSize int32
+	// LossTolerant specifies whether the listener keeps receiving messages after some messages are lost.
+	// Messages are lost if they are overwritten in the ringbuffer before the listener reads them,
+	// in which case reading them fails with hzerrors.ErrStaleSequence.
+	// A loss tolerant listener continues from the oldest message in the ringbuffer,
+	// otherwise the listener is removed.
+	// Defaults to false.
+	LossTolerant bool
+}
+
+// NewReliableMessageListenerConfig creates a ReliableMessageListenerConfig with the default values.
+func NewReliableMessageListenerConfig() ReliableMessageListenerConfig {
+	return ReliableMessageListenerConfig{
+		InitialSequence: reliableTopicLatestSequence,
+		BatchSize:       defaultReliableTopicBatchSize,
+	}
+}
+
+func (c *ReliableMessageListenerConfig) Validate() error {
+	if c.BatchSize == 0 {
+		c.BatchSize = defaultReliableTopicBatchSize
+	} else if err := check.WithinRangeInt32(c.BatchSize, 1, ringbufferMaxBatchSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+func newReliableTopic(p *proxy, rb *Ringbuffer) *ReliableTopic {
+	return &ReliableTopic{
+		proxy:   p,
+		rb:      rb,
+		mu:      &sync.Mutex{},
+		runners: map[types.UUID]*reliableMessageRunner{},
+	}
+}
+
+// AddMessageListener adds a subscriber to this topic.
+// The subscriber receives only the messages published after it is added.
+func (t *ReliableTopic) AddMessageListener(ctx context.Context, handler TopicMessageHandler) (types.UUID, error) {
+	return t.AddMessageListenerWithConfig(ctx, NewReliableMessageListenerConfig(), handler)
+}
+
+// AddMessageListenerWithConfig adds a subscriber to this topic using the given configuration.
+func (t *ReliableTopic) AddMessageListenerWithConfig(ctx context.Context, config ReliableMessageListenerConfig, handler TopicMessageHandler) (types.UUID, error) {
+	if err := config.Validate(); err != nil {
+		return types.UUID{}, err
+	}
+	sequence := config.InitialSequence
+	if sequence < 0 {
+		tail, err := t.rb.TailSequence(ctx)
+		if err != nil {
+			return types.UUID{}, err
+		}
+		sequence = tail + 1
+	}
+	subscriptionID := types.NewUUID()
+	runnerCtx, cancel := context.WithCancel(context.Background())
+	runner := &reliableMessageRunner{
+		topic:          t,
+		handler:        handler,
+		cancel:         cancel,
+		subscriptionID: subscriptionID,
+		sequence:       sequence,
+		batchSize:      int(config.BatchSize),
+		lossTolerant:   config.LossTolerant,
+	}
+	t.mu.Lock()
+	t.runners[subscriptionID] = runner
+	t.mu.Unlock()
+	go runner.run(runnerCtx)
+	return subscriptionID, nil
+}
+
+// Destroy removes this topic and its ringbuffer cluster-wide.
+// All message listeners of this topic are removed.
+func (t *ReliableTopic) Destroy(ctx context.Context) error {
+	t.mu.Lock()
+	for id, runner := range t.runners {
+		runner.cancel()
+		delete(t.runners, id)
+	}
+	t.mu.Unlock()
+	if err := t.rb.Destroy(ctx); err != nil {
+		return err
+	}
+	return t.proxy.Destroy(ctx)
+}
+
+// Publish publishes the given message to all subscribers of this topic.
+// If the ringbuffer is full, the call blocks until there is space for the message or the context is done.
+func (t *ReliableTopic) Publish(ctx context.Context, message interface{}) error {
+	msg, err := t.makeMessage(message)
+	if err != nil {
+		return err
+	}
+	return t.addWithBackoff(ctx, func() (int64, error) {
+		return t.rb.Add(ctx, msg, OverflowPolicyFail)
+	})
+}
+
+// PublishAll publishes all given messages to all subscribers of this topic.
+// If the ringbuffer does not have space for all messages, the call blocks until there is space or the context is done.
+func (t *ReliableTopic) PublishAll(ctx context.Context, messages ...interface{}) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	msgs := make([]interface{}, len(messages))
+	for i, message := range messages {
+		msg, err := t.makeMessage(message)
+		if err != nil {
+			return err
+		}
+		msgs[i] = msg
+	}
+	return t.addWithBackoff(ctx, func() (int64, error) {
+		return t.rb.AddAll(ctx, OverflowPolicyFail, msgs...)
+	})
+}
+
+// RemoveListener removes the given subscription from this topic.
+func (t *ReliableTopic) RemoveListener(ctx context.Context, subscriptionID types.UUID) error {
+	t.mu.Lock()
+	runner, ok := t.runners[subscriptionID]
+	delete(t.runners, subscriptionID)
+	t.mu.Unlock()
+	if ok {
+		runner.cancel()
+	}
+	return nil
+}
+
+func (t *ReliableTopic) makeMessage(message interface{}) (*iproxy.ReliableTopicMessage, error) {
+	messageData, err := t.validateAndSerialize(message)
+	if err != nil {
+		return nil, err
+	}
+	return &iproxy.ReliableTopicMessage{
+		PublishTime: time.Now().UnixNano() / 1_000_000,
+		Payload:     messageData.ToByteArray(),
+	}, nil
+}
+
+// addWithBackoff retries adding to the ringbuffer until there is space for the messages.
+func (t *ReliableTopic) addWithBackoff(ctx context.Context, add func() (int64, error)) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	backoff := reliableTopicMinBackoff
+	for {
+		if seq, err := add(); err != nil {
+			return err
+		} else if seq != -1 {
+			return nil
+		}
+		if err := sleepWithContext(ctx, backoff); err != nil {
+			return err
+		}
+		if backoff *= 2; backoff > reliableTopicMaxBackoff {
+			backoff = reliableTopicMaxBackoff
+		}
+	}
+}
+
+func (t *ReliableTopic) removeRunner(subscriptionID types.UUID) {
+	t.mu.Lock()
+	delete(t.runners, subscriptionID)
+	t.mu.Unlock()
+}
+
+func (t *ReliableTopic) publisherMember(addr *iproxy.Address) pubcluster.MemberInfo {
+	// prevent panic if member not found
+	var member pubcluster.MemberInfo
+	if addr == nil {
+		return member
+	}
+	target := pubcluster.NewAddress(addr.Host, addr.Port)
+	for _, m := range t.clusterService.OrderedMembers() {
+		if m.Address == target {
+			return m
+		}
+	}
+	return member
+}
+
+// reliableMessageRunner reads the messages of a reliable topic from its ringbuffer and delivers them to a listener.
+type reliableMessageRunner struct {
+	topic          *ReliableTopic
+	handler        TopicMessageHandler
+	cancel         context.CancelFunc
+	sequence       int64
+	batchSize      int
+	subscriptionID types.UUID
+	lossTolerant   bool
+}
+
+func (r *reliableMessageRunner) run(ctx context.Context) {
+	backoff := reliableTopicMinBackoff
+	for {
+		result, err := r.topic.rb.ReadMany(ctx, r.sequence, 1, r.batchSize, nil)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			if !r.handleError(ctx, err, backoff) {
+				r.terminate()
+				return
+			}
+			if backoff *= 2; backoff > reliableTopicMaxBackoff {
+				backoff = reliableTopicMaxBackoff
+			}
+			continue
+		}
+		backoff = reliableTopicMinBackoff
+		if !r.process(ctx, result) {
+			r.terminate()
+			return
+		}
+	}
+}
+
+// handleError returns true if the runner should continue reading messages after the given error.
+func (r *reliableMessageRunner) handleError(ctx context.Context, err error, backoff time.Duration) bool {
+	switch {
+	case errors.Is(err, hzerrors.ErrOperationTimeout):
+		// no messages were published during the blocking read
+		return true
+	case errors.Is(err, hzerrors.ErrStaleSequence), errors.Is(err, hzerrors.ErrIllegalArgument):
+		if !r.lossTolerant {
+			r.topic.logger.Warnf("terminating message listener %s of reliable topic %s, sequence %d is not available: %s",
+				r.subscriptionID, r.topic.name, r.sequence, err.Error())
+			return false
+		}
+		head, err := r.topic.rb.HeadSequence(ctx)
+		if err != nil {
+			return sleepWithContext(ctx, backoff) == nil
+		}
+		r.topic.logger.Warnf("message listener %s of reliable topic %s lost messages, sequence %d is not available, continuing from sequence %d",
+			r.subscriptionID, r.topic.name, r.sequence, head)
+		r.sequence = head
+		return true
+	case errors.Is(err, hzerrors.ErrClientNotActive), errors.Is(err, hzerrors.ErrDistributedObjectDestroyed):
+		return false
+	default:
+		r.topic.logger.Warnf("message listener %s of reliable topic %s failed reading messages, retrying: %s",
+			r.subscriptionID, r.topic.name, err.Error())
+		return sleepWithContext(ctx, backoff) == nil
+	}
+}
+
+// process delivers the read messages to the listener.
+// Returns false if the runner should stop.
+func (r *reliableMessageRunner) process(ctx context.Context, result *RingbufferReadResult) bool {
+	if lost := result.NextSequence - int64(result.ReadCount) - r.sequence; lost > 0 {
+		if !r.lossTolerant {
+			r.topic.logger.Warnf("terminating message listener %s of reliable topic %s, %d messages were lost",
+				r.subscriptionID, r.topic.name, lost)
+			return false
+		}
+		r.topic.logger.Warnf("message listener %s of reliable topic %s lost %d messages", r.subscriptionID, r.topic.name, lost)
+	}
+	for _, item := range result.Items {
+		if ctx.Err() != nil {
+			return true
+		}
+		msg, ok := item.(*iproxy.ReliableTopicMessage)
+		if !ok {
+			r.topic.logger.Warnf("unexpected item in the ringbuffer of reliable topic %s", r.topic.name)
+			continue
+		}
+		value, err := r.topic.convertToObject(iserialization.NewData(msg.Payload))
+		if err != nil {
+			r.topic.logger.Warnf("cannot convert data to Go value: %v", err)
+			continue
+		}
+		publishTime := time.Unix(0, msg.PublishTime*1_000_000)
+		r.handler(newMessagePublished(r.topic.name, value, publishTime, r.topic.publisherMember(msg.PublisherAddress)))
+	}
+	r.sequence = result.NextSequence
+	return true
+}
+
+func (r *reliableMessageRunner) terminate() {
+	r.cancel()
+	r.topic.removeRunner(r.subscriptionID)
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
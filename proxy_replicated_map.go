@@ -22,10 +22,12 @@ import (
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	inearcache "github.com/hazelcast/hazelcast-go-client/internal/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
 	iproxy "github.com/hazelcast/hazelcast-go-client/internal/proxy"
 	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -39,6 +41,7 @@ See https://docs.hazelcast.com/imdg/latest/data-structures/replicated-map.html f
 type ReplicatedMap struct {
 	*proxy
 	refIDGenerator *iproxy.ReferenceIDGenerator
+	ncm            *nearCacheMap
 	partitionID    int32
 }
 
@@ -59,6 +62,17 @@ func newReplicatedMap(p *proxy, refIDGenerator *iproxy.ReferenceIDGenerator) (*R
 	return rp, nil
 }
 
+func newNearCachedReplicatedMap(ctx context.Context, p *proxy, refIDGenerator *iproxy.ReferenceIDGenerator, ncm *inearcache.Manager, cfg nearcache.Config) (*ReplicatedMap, error) {
+	rp, err := newReplicatedMap(p, refIDGenerator)
+	if err != nil {
+		return nil, err
+	}
+	if rp.ncm, err = newNearCacheReplicatedMap(ctx, p, ncm, cfg); err != nil {
+		return nil, err
+	}
+	return rp, nil
+}
+
 // AddEntryListener adds a continuous entry listener to this map.
 func (m *ReplicatedMap) AddEntryListener(ctx context.Context, handler EntryNotifiedHandler) (types.UUID, error) {
 	return m.addEntryListener(ctx, nil, nil, handler)
@@ -81,6 +95,7 @@ func (m *ReplicatedMap) AddEntryListenerToKeyWithPredicate(ctx context.Context,
 
 // Clear deletes all entries one by one and fires related events
 func (m *ReplicatedMap) Clear(ctx context.Context) error {
+	defer m.clearNearCache()
 	request := codec.EncodeReplicatedMapClearRequest(m.name)
 	_, err := m.invokeOnRandomTarget(ctx, request, nil)
 	return err
@@ -122,6 +137,15 @@ func (m *ReplicatedMap) Get(ctx context.Context, key interface{}) (interface{},
 		return nil, err
 	} else {
 		request := codec.EncodeReplicatedMapGetRequest(m.name, keyData)
+		if m.ncm != nil {
+			return m.ncm.Get(keyData, func() (*iserialization.Data, error) {
+				response, err := m.invokeOnKey(ctx, request, keyData)
+				if err != nil {
+					return nil, err
+				}
+				return codec.DecodeReplicatedMapGetResponse(response), nil
+			})
+		}
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
 		} else {
@@ -188,11 +212,22 @@ func (m *ReplicatedMap) IsEmpty(ctx context.Context) (bool, error) {
 	}
 }
 
+// Destroy removes this map from the cluster, together with its Near Cache if there is one.
+func (m *ReplicatedMap) Destroy(ctx context.Context) error {
+	if m.ncm != nil {
+		if err := m.ncm.Destroy(ctx); err != nil {
+			m.logger.Warnf("removing Near Cache listener of %s: %s", m.name, err.Error())
+		}
+	}
+	return m.proxy.Destroy(ctx)
+}
+
 // Put sets the value for the given key and returns the old value.
 func (m *ReplicatedMap) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
 	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeReplicatedMapPutRequest(m.name, keyData, valueData, ttlUnlimited)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -206,6 +241,7 @@ func (m *ReplicatedMap) Put(ctx context.Context, key interface{}, value interfac
 // No atomicity guarantees are given. In the case of a failure, some key-value tuples may get written,
 // while others are not.
 func (m *ReplicatedMap) PutAll(ctx context.Context, keyValuePairs ...types.Entry) error {
+	defer m.clearNearCache()
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -231,6 +267,7 @@ func (m *ReplicatedMap) Remove(ctx context.Context, key interface{}) (interface{
 	if keyData, err := m.validateAndSerialize(key); err != nil {
 		return nil, err
 	} else {
+		defer m.invalidateNearCache(keyData)
 		request := codec.EncodeReplicatedMapRemoveRequest(m.name, keyData)
 		if response, err := m.invokeOnKey(ctx, request, keyData); err != nil {
 			return nil, err
@@ -306,3 +343,15 @@ func (m *ReplicatedMap) makeListenerDecoder(msg *proto.ClientMessage, keyData, p
 		codec.HandleReplicatedMapAddEntryListener(msg, handler)
 	}
 }
+
+func (m *ReplicatedMap) invalidateNearCache(keyData *iserialization.Data) {
+	if m.ncm != nil {
+		m.ncm.Invalidate(keyData)
+	}
+}
+
+func (m *ReplicatedMap) clearNearCache() {
+	if m.ncm != nil {
+		m.ncm.Clear()
+	}
+}
@@ -0,0 +1,232 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// OverflowPolicy specifies what to do when an item is added to a full Ringbuffer.
+type OverflowPolicy int32
+
+const (
+	// OverflowPolicyOverwrite overwrites the oldest item in the ringbuffer, even if its time to live has not expired yet.
+	OverflowPolicyOverwrite OverflowPolicy = 0
+	// OverflowPolicyFail does not add the item and returns -1 as the sequence.
+	OverflowPolicyFail OverflowPolicy = 1
+)
+
+// ringbufferMaxBatchSize is the maximum number of items which can be read at once with ReadMany.
+const ringbufferMaxBatchSize = 1000
+
+/*
+Ringbuffer is a lock-free distributed data-structure that stores its data in a ring-like structure.
+
+A ringbuffer has a capacity, so it will not grow beyond that capacity and endanger the stability of the system.
+If that capacity is exceeded, the oldest item in the ringbuffer is overwritten, unless OverflowPolicyFail is used.
+
+Each item in the ringbuffer has a sequence, which is assigned when the item is added.
+The head sequence is the sequence of the oldest item and the tail sequence is the sequence of the newest item.
+Reading an item does not remove it from the ringbuffer, so the same item can be read multiple times.
+
+Ringbuffer is not a partitioned data-structure.
+All of the Ringbuffer content is stored in a single machine (and in the backup).
+
+For details see https://docs.hazelcast.com/imdg/latest/data-structures/ringbuffer.html
+*/
+type Ringbuffer struct {
+	*proxy
+	partitionID int32
+}
+
+// RingbufferReadResult is the result of a Ringbuffer.ReadMany call.
+type RingbufferReadResult struct {
+	// Items contains the items which were read.
+	Items []interface{}
+	// ItemSequences contains the sequence of each item in Items.
+	ItemSequences []int64
+	// NextSequence is the sequence of the item following the last read item.
+	NextSequence int64
+	// ReadCount is the number of items which were read, including the items filtered out on the server side.
+	ReadCount int
+}
+
+func newRingbuffer(p *proxy) (*Ringbuffer, error) {
+	if partitionID, err := p.stringToPartitionID(p.name); err != nil {
+		return nil, err
+	} else {
+		return &Ringbuffer{proxy: p, partitionID: partitionID}, nil
+	}
+}
+
+// Add adds an item to the tail of this ringbuffer.
+// Returns the sequence of the added item.
+// If the ringbuffer is full and the overflow policy is OverflowPolicyFail, the item is not added and -1 is returned.
+func (rb *Ringbuffer) Add(ctx context.Context, item interface{}, overflowPolicy OverflowPolicy) (int64, error) {
+	if itemData, err := rb.validateAndSerialize(item); err != nil {
+		return 0, err
+	} else {
+		request := codec.EncodeRingbufferAddRequest(rb.name, int32(overflowPolicy), itemData)
+		if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+			return 0, err
+		} else {
+			return codec.DecodeRingbufferAddResponse(response), nil
+		}
+	}
+}
+
+// AddAll adds all of the given items to the tail of this ringbuffer.
+// Returns the sequence of the last added item.
+// If the ringbuffer does not have enough space and the overflow policy is OverflowPolicyFail, no items are added and -1 is returned.
+func (rb *Ringbuffer) AddAll(ctx context.Context, overflowPolicy OverflowPolicy, items ...interface{}) (int64, error) {
+	if len(items) == 0 {
+		return 0, ihzerrors.NewIllegalArgumentError("items cannot be empty", nil)
+	}
+	if itemsData, err := rb.validateAndSerializeValues(items); err != nil {
+		return 0, err
+	} else {
+		request := codec.EncodeRingbufferAddAllRequest(rb.name, itemsData, int32(overflowPolicy))
+		if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+			return 0, err
+		} else {
+			return codec.DecodeRingbufferAddAllResponse(response), nil
+		}
+	}
+}
+
+// Capacity returns the capacity of this ringbuffer.
+func (rb *Ringbuffer) Capacity(ctx context.Context) (int64, error) {
+	request := codec.EncodeRingbufferCapacityRequest(rb.name)
+	if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeRingbufferCapacityResponse(response), nil
+	}
+}
+
+// HeadSequence returns the sequence of the head.
+// The head is the side of the ringbuffer where the oldest items are found.
+// If the ringbuffer is empty, the head is one more than the tail.
+func (rb *Ringbuffer) HeadSequence(ctx context.Context) (int64, error) {
+	request := codec.EncodeRingbufferHeadSequenceRequest(rb.name)
+	if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeRingbufferHeadSequenceResponse(response), nil
+	}
+}
+
+// ReadMany reads a batch of items from this ringbuffer, starting at startSequence.
+// The call blocks until at least minCount items are available, and returns at most maxCount items.
+// If filter is not nil, only the items which pass the filter are returned.
+// The filter must be serializable and have a counterpart on the server side.
+func (rb *Ringbuffer) ReadMany(ctx context.Context, startSequence int64, minCount int, maxCount int, filter interface{}) (*RingbufferReadResult, error) {
+	if startSequence < 0 {
+		return nil, ihzerrors.NewIllegalArgumentError("sequence cannot be negative", nil)
+	}
+	minCountAsInt32, err := check.NonNegativeInt32(minCount)
+	if err != nil {
+		return nil, err
+	}
+	maxCountAsInt32, err := check.NonNegativeInt32(maxCount)
+	if err != nil {
+		return nil, err
+	}
+	if minCount > maxCount {
+		return nil, ihzerrors.NewIllegalArgumentError("minCount cannot be larger than maxCount", nil)
+	}
+	if maxCount > ringbufferMaxBatchSize {
+		return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("maxCount cannot be larger than %d", ringbufferMaxBatchSize), nil)
+	}
+	var filterData *iserialization.Data
+	if filter != nil {
+		if filterData, err = rb.validateAndSerialize(filter); err != nil {
+			return nil, err
+		}
+	}
+	request := codec.EncodeRingbufferReadManyRequest(rb.name, startSequence, minCountAsInt32, maxCountAsInt32, filterData)
+	response, err := rb.invokeOnPartition(ctx, request, rb.partitionID)
+	if err != nil {
+		return nil, err
+	}
+	readCount, itemsData, itemSeqs, nextSeq := codec.DecodeRingbufferReadManyResponse(response)
+	items, err := rb.convertToObjects(itemsData)
+	if err != nil {
+		return nil, err
+	}
+	return &RingbufferReadResult{
+		Items:         items,
+		ItemSequences: itemSeqs,
+		NextSequence:  nextSeq,
+		ReadCount:     int(readCount),
+	}, nil
+}
+
+// ReadOne reads the item with the given sequence.
+// If the sequence is one beyond the tail, the call blocks until an item is added.
+// Reading an item does not remove it from the ringbuffer.
+func (rb *Ringbuffer) ReadOne(ctx context.Context, sequence int64) (interface{}, error) {
+	if sequence < 0 {
+		return nil, ihzerrors.NewIllegalArgumentError("sequence cannot be negative", nil)
+	}
+	request := codec.EncodeRingbufferReadOneRequest(rb.name, sequence)
+	if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+		return nil, err
+	} else {
+		return rb.convertToObject(codec.DecodeRingbufferReadOneResponse(response))
+	}
+}
+
+// RemainingCapacity returns the remaining capacity of this ringbuffer.
+// If time to live is not set, the remaining capacity is always the capacity.
+func (rb *Ringbuffer) RemainingCapacity(ctx context.Context) (int64, error) {
+	request := codec.EncodeRingbufferRemainingCapacityRequest(rb.name)
+	if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeRingbufferRemainingCapacityResponse(response), nil
+	}
+}
+
+// Size returns the number of items in this ringbuffer.
+// If time to live is not set, the size is equal to the capacity once the ringbuffer is full.
+func (rb *Ringbuffer) Size(ctx context.Context) (int64, error) {
+	request := codec.EncodeRingbufferSizeRequest(rb.name)
+	if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeRingbufferSizeResponse(response), nil
+	}
+}
+
+// TailSequence returns the sequence of the tail.
+// The tail is the side of the ringbuffer where the items are added to.
+// The initial value of the tail is -1.
+func (rb *Ringbuffer) TailSequence(ctx context.Context) (int64, error) {
+	request := codec.EncodeRingbufferTailSequenceRequest(rb.name)
+	if response, err := rb.invokeOnPartition(ctx, request, rb.partitionID); err != nil {
+		return 0, err
+	} else {
+		return codec.DecodeRingbufferTailSequenceResponse(response), nil
+	}
+}
@@ -0,0 +1,344 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	iserialization "github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	scheduledTaskTypeSingleRun   byte = 0
+	scheduledTaskTypeAtFixedRate byte = 1
+)
+
+const (
+	scheduledTaskHandlerURNBase      = "urn:hzScheduledTaskHandler:"
+	scheduledTaskHandlerURNSeparator = "\x00"
+	scheduledTaskHandlerNoMember     = "-"
+)
+
+/*
+ScheduledExecutorService executes tasks on the members of the cluster after a delay or periodically.
+
+Tasks are stored either in a partition or on a member.
+The tasks stored in a partition are replicated to the backups of the partition, so they survive member crashes.
+Scheduling a task returns a ScheduledFuture, which can be used to query or cancel the task and get its result.
+The ScheduledTaskHandler of a ScheduledFuture identifies the task in the cluster,
+so its URN can be used to get the ScheduledFuture of the task in another process with GetScheduledFuture.
+
+Tasks must be serializable, so they must be IdentifiedDataSerializable or Portable
+and have a counterpart Callable or Runnable implementation on the member side.
+
+For details see https://docs.hazelcast.com/imdg/latest/computing/scheduled-executor-service.html
+*/
+type ScheduledExecutorService struct {
+	*proxy
+}
+
+func newScheduledExecutorService(p *proxy) *ScheduledExecutorService {
+	return &ScheduledExecutorService{proxy: p}
+}
+
+// GetScheduledFuture returns the ScheduledFuture of the task identified by the given handler.
+func (s *ScheduledExecutorService) GetScheduledFuture(handler ScheduledTaskHandler) *ScheduledFuture {
+	return &ScheduledFuture{executor: s, handler: handler}
+}
+
+// Schedule schedules the task to be executed once after the given delay.
+func (s *ScheduledExecutorService) Schedule(ctx context.Context, task interface{}, delay time.Duration) (*ScheduledFuture, error) {
+	return s.scheduleOnPartition(ctx, task, randomPartitionID(s.partitionService.PartitionCount()), scheduledTaskTypeSingleRun, delay, 0)
+}
+
+// ScheduleAtFixedRate schedules the task to be executed periodically.
+// The first execution happens after initialDelay, and the subsequent executions happen after each period.
+func (s *ScheduledExecutorService) ScheduleAtFixedRate(ctx context.Context, task interface{}, initialDelay time.Duration, period time.Duration) (*ScheduledFuture, error) {
+	if period <= 0 {
+		return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("period must be positive: %s", period), nil)
+	}
+	return s.scheduleOnPartition(ctx, task, randomPartitionID(s.partitionService.PartitionCount()), scheduledTaskTypeAtFixedRate, initialDelay, period)
+}
+
+// ScheduleOnAllMembers schedules the task to be executed once on each member after the given delay.
+func (s *ScheduledExecutorService) ScheduleOnAllMembers(ctx context.Context, task interface{}, delay time.Duration) ([]*ScheduledFuture, error) {
+	taskData, err := s.validateScheduledTask(task, delay)
+	if err != nil {
+		return nil, err
+	}
+	members := s.clusterService.OrderedMembers()
+	futures := make([]*ScheduledFuture, 0, len(members))
+	for i := range members {
+		member := &members[i]
+		taskName := types.NewUUID().String()
+		request := codec.EncodeScheduledExecutorSubmitToMemberRequest(s.name, member.UUID, scheduledTaskTypeSingleRun, taskName, taskData, delay.Milliseconds(), 0, false)
+		if _, err := s.invokeOnMember(ctx, request, member); err != nil {
+			return nil, err
+		}
+		handler := ScheduledTaskHandler{
+			SchedulerName: s.name,
+			TaskName:      taskName,
+			MemberUUID:    member.UUID,
+			PartitionID:   -1,
+		}
+		futures = append(futures, s.GetScheduledFuture(handler))
+	}
+	return futures, nil
+}
+
+// ScheduleOnKeyOwner schedules the task to be executed once after the given delay in the partition of the given key.
+func (s *ScheduledExecutorService) ScheduleOnKeyOwner(ctx context.Context, task interface{}, key interface{}, delay time.Duration) (*ScheduledFuture, error) {
+	keyData, err := s.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := s.partitionService.GetPartitionID(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return s.scheduleOnPartition(ctx, task, partitionID, scheduledTaskTypeSingleRun, delay, 0)
+}
+
+// Shutdown shuts down the executor on all members.
+// The previously scheduled tasks are executed, but new tasks are rejected.
+func (s *ScheduledExecutorService) Shutdown(ctx context.Context) error {
+	members := s.clusterService.OrderedMembers()
+	for i := range members {
+		request := codec.EncodeScheduledExecutorShutdownRequest(s.name, members[i].UUID)
+		if _, err := s.invokeOnMember(ctx, request, &members[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ScheduledExecutorService) scheduleOnPartition(ctx context.Context, task interface{}, partitionID int32, taskType byte, delay time.Duration, period time.Duration) (*ScheduledFuture, error) {
+	taskData, err := s.validateScheduledTask(task, delay)
+	if err != nil {
+		return nil, err
+	}
+	taskName := types.NewUUID().String()
+	request := codec.EncodeScheduledExecutorSubmitToPartitionRequest(s.name, taskType, taskName, taskData, delay.Milliseconds(), period.Milliseconds(), false)
+	if _, err := s.invokeOnPartition(ctx, request, partitionID); err != nil {
+		return nil, err
+	}
+	handler := ScheduledTaskHandler{
+		SchedulerName: s.name,
+		TaskName:      taskName,
+		PartitionID:   partitionID,
+	}
+	return s.GetScheduledFuture(handler), nil
+}
+
+func (s *ScheduledExecutorService) validateScheduledTask(task interface{}, delay time.Duration) (*iserialization.Data, error) {
+	if delay < 0 {
+		return nil, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("delay must be non-negative: %s", delay), nil)
+	}
+	return s.validateAndSerializeTask(task)
+}
+
+// ScheduledTaskHandler identifies a task scheduled with a ScheduledExecutorService.
+// A task is stored either in a partition or on a member.
+type ScheduledTaskHandler struct {
+	// SchedulerName is the name of the ScheduledExecutorService.
+	SchedulerName string
+	// TaskName is the name of the task.
+	TaskName string
+	// MemberUUID is the UUID of the member which stores the task, if the task is stored on a member.
+	MemberUUID types.UUID
+	// PartitionID is the ID of the partition which stores the task, or -1 if the task is stored on a member.
+	PartitionID int32
+}
+
+// NewScheduledTaskHandlerFromURN creates a ScheduledTaskHandler from the given URN.
+// The URN format is compatible with the other Hazelcast clients.
+func NewScheduledTaskHandlerFromURN(urn string) (ScheduledTaskHandler, error) {
+	if !strings.HasPrefix(urn, scheduledTaskHandlerURNBase) {
+		return ScheduledTaskHandler{}, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("invalid scheduled task handler URN: %s", urn), nil)
+	}
+	parts := strings.Split(urn[len(scheduledTaskHandlerURNBase):], scheduledTaskHandlerURNSeparator)
+	if len(parts) != 4 {
+		return ScheduledTaskHandler{}, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("invalid scheduled task handler URN: %s", urn), nil)
+	}
+	var memberUUID types.UUID
+	if parts[0] != scheduledTaskHandlerNoMember {
+		var err error
+		if memberUUID, err = parseUUID(parts[0]); err != nil {
+			return ScheduledTaskHandler{}, ihzerrors.NewIllegalArgumentError("invalid member UUID in scheduled task handler URN", err)
+		}
+	}
+	partitionID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return ScheduledTaskHandler{}, ihzerrors.NewIllegalArgumentError("invalid partition ID in scheduled task handler URN", err)
+	}
+	return ScheduledTaskHandler{
+		SchedulerName: parts[2],
+		TaskName:      parts[3],
+		MemberUUID:    memberUUID,
+		PartitionID:   int32(partitionID),
+	}, nil
+}
+
+// URN returns the URN of the handler, which can be used to create the same handler in another process.
+func (h ScheduledTaskHandler) URN() string {
+	member := scheduledTaskHandlerNoMember
+	if !h.onPartition() {
+		member = h.MemberUUID.String()
+	}
+	return scheduledTaskHandlerURNBase + strings.Join([]string{
+		member, strconv.Itoa(int(h.PartitionID)), h.SchedulerName, h.TaskName,
+	}, scheduledTaskHandlerURNSeparator)
+}
+
+func (h ScheduledTaskHandler) onPartition() bool {
+	return h.MemberUUID.Default()
+}
+
+// ScheduledFuture is the handle of a task scheduled with a ScheduledExecutorService.
+type ScheduledFuture struct {
+	executor *ScheduledExecutorService
+	handler  ScheduledTaskHandler
+}
+
+// Cancel cancels the further executions of the task.
+// Returns false if the task is already done or cancelled.
+func (f *ScheduledFuture) Cancel(ctx context.Context) (bool, error) {
+	h := f.handler
+	if h.onPartition() {
+		request := codec.EncodeScheduledExecutorCancelFromPartitionRequest(h.SchedulerName, h.TaskName, false)
+		return f.invokeBool(ctx, request, codec.DecodeScheduledExecutorCancelFromPartitionResponse)
+	}
+	request := codec.EncodeScheduledExecutorCancelFromMemberRequest(h.SchedulerName, h.TaskName, h.MemberUUID, false)
+	return f.invokeBool(ctx, request, codec.DecodeScheduledExecutorCancelFromMemberResponse)
+}
+
+// Dispose cancels the task and removes it and its result from the executor.
+func (f *ScheduledFuture) Dispose(ctx context.Context) error {
+	h := f.handler
+	var request *proto.ClientMessage
+	if h.onPartition() {
+		request = codec.EncodeScheduledExecutorDisposeFromPartitionRequest(h.SchedulerName, h.TaskName)
+	} else {
+		request = codec.EncodeScheduledExecutorDisposeFromMemberRequest(h.SchedulerName, h.TaskName, h.MemberUUID)
+	}
+	_, err := f.invoke(ctx, request)
+	return err
+}
+
+// GetDelay returns the remaining delay until the next execution of the task.
+func (f *ScheduledFuture) GetDelay(ctx context.Context) (time.Duration, error) {
+	h := f.handler
+	var request *proto.ClientMessage
+	if h.onPartition() {
+		request = codec.EncodeScheduledExecutorGetDelayFromPartitionRequest(h.SchedulerName, h.TaskName)
+	} else {
+		request = codec.EncodeScheduledExecutorGetDelayFromMemberRequest(h.SchedulerName, h.TaskName, h.MemberUUID)
+	}
+	response, err := f.invoke(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	// both responses contain the delay in nanoseconds
+	return time.Duration(codec.DecodeScheduledExecutorGetDelayFromPartitionResponse(response)), nil
+}
+
+// GetResult blocks until the task completes or the context is done and returns the result of the task.
+func (f *ScheduledFuture) GetResult(ctx context.Context) (interface{}, error) {
+	h := f.handler
+	var request *proto.ClientMessage
+	if h.onPartition() {
+		request = codec.EncodeScheduledExecutorGetResultFromPartitionRequest(h.SchedulerName, h.TaskName)
+	} else {
+		request = codec.EncodeScheduledExecutorGetResultFromMemberRequest(h.SchedulerName, h.TaskName, h.MemberUUID)
+	}
+	response, err := f.invoke(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return f.executor.convertToObject(codec.DecodeScheduledExecutorGetResultFromPartitionResponse(response))
+}
+
+// Handler returns the handler of the task.
+func (f *ScheduledFuture) Handler() ScheduledTaskHandler {
+	return f.handler
+}
+
+// IsCancelled returns true if the task was cancelled.
+func (f *ScheduledFuture) IsCancelled(ctx context.Context) (bool, error) {
+	h := f.handler
+	if h.onPartition() {
+		request := codec.EncodeScheduledExecutorIsCancelledFromPartitionRequest(h.SchedulerName, h.TaskName)
+		return f.invokeBool(ctx, request, codec.DecodeScheduledExecutorIsCancelledFromPartitionResponse)
+	}
+	request := codec.EncodeScheduledExecutorIsCancelledFromMemberRequest(h.SchedulerName, h.TaskName, h.MemberUUID)
+	return f.invokeBool(ctx, request, codec.DecodeScheduledExecutorIsCancelledFromMemberResponse)
+}
+
+// IsDone returns true if the task completed or was cancelled.
+func (f *ScheduledFuture) IsDone(ctx context.Context) (bool, error) {
+	h := f.handler
+	if h.onPartition() {
+		request := codec.EncodeScheduledExecutorIsDoneFromPartitionRequest(h.SchedulerName, h.TaskName)
+		return f.invokeBool(ctx, request, codec.DecodeScheduledExecutorIsDoneFromPartitionResponse)
+	}
+	request := codec.EncodeScheduledExecutorIsDoneFromMemberRequest(h.SchedulerName, h.TaskName, h.MemberUUID)
+	return f.invokeBool(ctx, request, codec.DecodeScheduledExecutorIsDoneFromMemberResponse)
+}
+
+// invoke sends the request to the partition or the member which stores the task.
+func (f *ScheduledFuture) invoke(ctx context.Context, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	if f.handler.onPartition() {
+		return f.executor.invokeOnPartition(ctx, request, f.handler.PartitionID)
+	}
+	member := f.executor.clusterService.GetMemberByUUID(f.handler.MemberUUID)
+	if member == nil {
+		msg := fmt.Sprintf("member %s which stores the task is not in the cluster", f.handler.MemberUUID)
+		return nil, ihzerrors.NewClientError(msg, nil, hzerrors.ErrTargetNotMember)
+	}
+	return f.executor.invokeOnMember(ctx, request, member)
+}
+
+func (f *ScheduledFuture) invokeBool(ctx context.Context, request *proto.ClientMessage, decode func(*proto.ClientMessage) bool) (bool, error) {
+	response, err := f.invoke(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	return decode(response), nil
+}
+
+// parseUUID parses the canonical string representation of a UUID.
+func parseUUID(s string) (types.UUID, error) {
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return types.UUID{}, fmt.Errorf("invalid UUID: %s", s)
+	}
+	b, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil {
+		return types.UUID{}, fmt.Errorf("invalid UUID: %s: %w", s, err)
+	}
+	return types.NewUUIDWith(binary.BigEndian.Uint64(b[:8]), binary.BigEndian.Uint64(b[8:])), nil
+}
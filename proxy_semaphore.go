@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/check"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// semaphoreDrainSessionAcquireCount is the number of times the session is acquired before draining permits.
+// The session is released for the permits which were not drained.
+const semaphoreDrainSessionAcquireCount = 1024
+
+/*
+Semaphore is a linearizable, distributed counting semaphore backed by the CP Subsystem.
+
+A semaphore has a number of permits.
+Acquire blocks until the requested number of permits are available, and Release adds permits back to the semaphore.
+The number of permits must be set with Init before use.
+
+Semaphores are session-aware by default.
+The permits acquired by a session-aware semaphore are bound to the CP session of the client,
+so they are released automatically if the client dies and its session expires.
+If JDK compatibility is enabled for the semaphore on the members, the semaphore is sessionless.
+The permits acquired by a sessionless semaphore are not released automatically,
+and they can be released by any client, even if they were not acquired by it.
+
+For details see https://docs.hazelcast.com/imdg/latest/cp-subsystem/isemaphore.html
+*/
+type Semaphore struct {
+	*cpProxy
+	sessions    *cpSessionManager
+	sessionless bool
+}
+
+func newSemaphore(p *cpProxy, sessions *cpSessionManager, sessionless bool) *Semaphore {
+	return &Semaphore{
+		cpProxy:     p,
+		sessions:    sessions,
+		sessionless: sessionless,
+	}
+}
+
+// Acquire acquires the given number of permits, blocking until they are available or the context is done.
+func (s *Semaphore) Acquire(ctx context.Context, permits int) error {
+	n, err := positivePermits(permits)
+	if err != nil {
+		return err
+	}
+	if s.sessionless {
+		_, err = s.acquireSessionless(ctx, n, -1)
+	} else {
+		_, err = s.acquireSessionAware(ctx, n, -1)
+	}
+	if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+		msg := fmt.Sprintf("semaphore %s not acquired because the acquire call on the CP group is cancelled", s.proxyName)
+		return ihzerrors.NewClientError(msg, err, hzerrors.ErrIllegalState)
+	}
+	return err
+}
+
+// AvailablePermits returns the number of permits which are currently available.
+func (s *Semaphore) AvailablePermits(ctx context.Context) (int, error) {
+	request := codec.EncodeSemaphoreAvailablePermitsRequest(s.groupID, s.name)
+	if response, err := s.invokeOnCPGroup(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return int(codec.DecodeSemaphoreAvailablePermitsResponse(response)), nil
+	}
+}
+
+// Change increases the number of available permits by the given delta, or decreases it if delta is negative.
+// The number of available permits may become negative.
+func (s *Semaphore) Change(ctx context.Context, delta int) error {
+	if delta < math.MinInt32 || delta > math.MaxInt32 {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("signed 32-bit integer number expected: %d", delta), nil)
+	}
+	if delta == 0 {
+		return nil
+	}
+	if s.sessionless {
+		threadID, err := s.sessions.uniqueThreadID(ctx, s.groupID)
+		if err != nil {
+			return err
+		}
+		request := codec.EncodeSemaphoreChangeRequest(s.groupID, s.name, cpNoSessionID, threadID, types.NewUUID(), int32(delta))
+		_, err = s.invokeOnCPGroup(ctx, request)
+		return err
+	}
+	sessionID, err := s.sessions.acquireSession(ctx, s.groupID, 1)
+	if err != nil {
+		return err
+	}
+	defer s.sessions.releaseSession(s.groupID, sessionID, 1)
+	request := codec.EncodeSemaphoreChangeRequest(s.groupID, s.name, sessionID, extractLockID(ctx), types.NewUUID(), int32(delta))
+	_, err = s.invokeOnCPGroup(ctx, request)
+	if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+		s.sessions.invalidateSession(s.groupID, sessionID)
+		return ihzerrors.NewClientError(fmt.Sprintf("session of semaphore %s expired", s.proxyName), err, hzerrors.ErrIllegalState)
+	}
+	return err
+}
+
+// Drain acquires all available permits and returns their number.
+func (s *Semaphore) Drain(ctx context.Context) (int, error) {
+	if s.sessionless {
+		threadID, err := s.sessions.uniqueThreadID(ctx, s.groupID)
+		if err != nil {
+			return 0, err
+		}
+		request := codec.EncodeSemaphoreDrainRequest(s.groupID, s.name, cpNoSessionID, threadID, types.NewUUID())
+		response, err := s.invokeOnCPGroup(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+		return int(codec.DecodeSemaphoreDrainResponse(response)), nil
+	}
+	threadID := extractLockID(ctx)
+	invocationUID := types.NewUUID()
+	for {
+		sessionID, err := s.sessions.acquireSession(ctx, s.groupID, semaphoreDrainSessionAcquireCount)
+		if err != nil {
+			return 0, err
+		}
+		request := codec.EncodeSemaphoreDrainRequest(s.groupID, s.name, sessionID, threadID, invocationUID)
+		response, err := s.invokeOnCPGroup(ctx, request)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				s.sessions.invalidateSession(s.groupID, sessionID)
+				continue
+			}
+			s.sessions.releaseSession(s.groupID, sessionID, semaphoreDrainSessionAcquireCount)
+			return 0, err
+		}
+		count := codec.DecodeSemaphoreDrainResponse(response)
+		s.sessions.releaseSession(s.groupID, sessionID, semaphoreDrainSessionAcquireCount-count)
+		return int(count), nil
+	}
+}
+
+// Init sets the number of available permits, if the semaphore was not initialized before.
+// Returns true if the semaphore is initialized by this call.
+func (s *Semaphore) Init(ctx context.Context, permits int) (bool, error) {
+	n, err := check.NonNegativeInt32(permits)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeSemaphoreInitRequest(s.groupID, s.name, n)
+	if response, err := s.invokeOnCPGroup(ctx, request); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeSemaphoreInitResponse(response), nil
+	}
+}
+
+// Release releases the given number of permits.
+// Session-aware semaphores can release only the permits which were acquired by the client.
+func (s *Semaphore) Release(ctx context.Context, permits int) error {
+	n, err := positivePermits(permits)
+	if err != nil {
+		return err
+	}
+	if s.sessionless {
+		threadID, err := s.sessions.uniqueThreadID(ctx, s.groupID)
+		if err != nil {
+			return err
+		}
+		request := codec.EncodeSemaphoreReleaseRequest(s.groupID, s.name, cpNoSessionID, threadID, types.NewUUID(), n)
+		_, err = s.invokeOnCPGroup(ctx, request)
+		return err
+	}
+	sessionID := s.sessions.sessionID(s.groupID)
+	if sessionID == cpNoSessionID {
+		return s.notAcquiredError(nil)
+	}
+	request := codec.EncodeSemaphoreReleaseRequest(s.groupID, s.name, sessionID, extractLockID(ctx), types.NewUUID(), n)
+	_, err = s.invokeOnCPGroup(ctx, request)
+	s.sessions.releaseSession(s.groupID, sessionID, n)
+	if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+		s.sessions.invalidateSession(s.groupID, sessionID)
+		return s.notAcquiredError(err)
+	}
+	return err
+}
+
+// TryAcquire acquires the given number of permits if they become available within the given timeout.
+// Returns true if the permits were acquired.
+func (s *Semaphore) TryAcquire(ctx context.Context, permits int, timeout time.Duration) (bool, error) {
+	n, err := positivePermits(permits)
+	if err != nil {
+		return false, err
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+	var acquired bool
+	if s.sessionless {
+		acquired, err = s.acquireSessionless(ctx, n, timeout)
+	} else {
+		acquired, err = s.acquireSessionAware(ctx, n, timeout)
+	}
+	if errors.Is(err, hzerrors.ErrWaitKeyCancelledException) {
+		return false, nil
+	}
+	return acquired, err
+}
+
+// acquireSessionAware acquires permits within the session of the client.
+// A negative timeout blocks until the permits are acquired.
+func (s *Semaphore) acquireSessionAware(ctx context.Context, permits int32, timeout time.Duration) (bool, error) {
+	threadID := extractLockID(ctx)
+	invocationUID := types.NewUUID()
+	for {
+		start := time.Now()
+		sessionID, err := s.sessions.acquireSession(ctx, s.groupID, permits)
+		if err != nil {
+			return false, err
+		}
+		request := codec.EncodeSemaphoreAcquireRequest(s.groupID, s.name, sessionID, threadID, invocationUID, permits, cpTimeoutMillis(timeout))
+		response, err := s.invokeOnCPGroup(ctx, request)
+		if err != nil {
+			if errors.Is(err, hzerrors.ErrSessionExpiredException) {
+				s.sessions.invalidateSession(s.groupID, sessionID)
+				if timeout >= 0 {
+					if timeout -= time.Since(start); timeout <= 0 {
+						return false, nil
+					}
+				}
+				continue
+			}
+			s.sessions.releaseSession(s.groupID, sessionID, permits)
+			return false, err
+		}
+		acquired := codec.DecodeSemaphoreAcquireResponse(response)
+		if !acquired {
+			s.sessions.releaseSession(s.groupID, sessionID, permits)
+		}
+		return acquired, nil
+	}
+}
+
+// acquireSessionless acquires permits without a session.
+// A negative timeout blocks until the permits are acquired.
+func (s *Semaphore) acquireSessionless(ctx context.Context, permits int32, timeout time.Duration) (bool, error) {
+	threadID, err := s.sessions.uniqueThreadID(ctx, s.groupID)
+	if err != nil {
+		return false, err
+	}
+	request := codec.EncodeSemaphoreAcquireRequest(s.groupID, s.name, cpNoSessionID, threadID, types.NewUUID(), permits, cpTimeoutMillis(timeout))
+	if response, err := s.invokeOnCPGroup(ctx, request); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeSemaphoreAcquireResponse(response), nil
+	}
+}
+
+func (s *Semaphore) notAcquiredError(err error) error {
+	msg := fmt.Sprintf("no valid session for semaphore %s, permits were not acquired or the session expired", s.proxyName)
+	return ihzerrors.NewClientError(msg, err, hzerrors.ErrIllegalState)
+}
+
+// cpTimeoutMillis converts the given timeout to milliseconds, negative timeouts are converted to -1 which means no timeout.
+func cpTimeoutMillis(timeout time.Duration) int64 {
+	if timeout < 0 {
+		return -1
+	}
+	return timeout.Milliseconds()
+}
+
+func positivePermits(permits int) (int32, error) {
+	if permits <= 0 {
+		return 0, ihzerrors.NewIllegalArgumentError(fmt.Sprintf("permits must be positive: %d", permits), nil)
+	}
+	return check.NonNegativeInt32(permits)
+}
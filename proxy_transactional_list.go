@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalList is the transactional counterpart of List.
+The changes made through a TransactionalList are visible to others only after the transaction is committed.
+
+Use TransactionContext.GetList to create a TransactionalList instance.
+*/
+type TransactionalList struct {
+	*transactionalProxy
+}
+
+// Add adds the given item to the list.
+// Returns true if the list changed.
+func (l *TransactionalList) Add(ctx context.Context, item interface{}) (bool, error) {
+	if itemData, err := l.validateAndSerialize(item); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalListAddRequest(l.name, l.txn.txnID, l.txn.threadID, itemData)
+		if response, err := l.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalListAddResponse(response), nil
+		}
+	}
+}
+
+// Remove removes the given item from the list.
+// Returns true if the list changed.
+func (l *TransactionalList) Remove(ctx context.Context, item interface{}) (bool, error) {
+	if itemData, err := l.validateAndSerialize(item); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalListRemoveRequest(l.name, l.txn.txnID, l.txn.threadID, itemData)
+		if response, err := l.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalListRemoveResponse(response), nil
+		}
+	}
+}
+
+// Size returns the number of items in the list.
+func (l *TransactionalList) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalListSizeRequest(l.name, l.txn.txnID, l.txn.threadID)
+	if response, err := l.invoke(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return int(codec.DecodeTransactionalListSizeResponse(response)), nil
+	}
+}
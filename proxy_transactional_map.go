@@ -0,0 +1,280 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+)
+
+/*
+TransactionalMap is the transactional counterpart of Map.
+The changes made through a TransactionalMap are visible to others only after the transaction is committed.
+
+Use TransactionContext.GetMap to create a TransactionalMap instance.
+*/
+type TransactionalMap struct {
+	*transactionalProxy
+}
+
+// ContainsKey returns true if the map contains an entry with the given key.
+func (m *TransactionalMap) ContainsKey(ctx context.Context, key interface{}) (bool, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalMapContainsKeyRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalMapContainsKeyResponse(response), nil
+		}
+	}
+}
+
+// ContainsValue returns true if the map contains an entry with the given value.
+func (m *TransactionalMap) ContainsValue(ctx context.Context, value interface{}) (bool, error) {
+	if valueData, err := m.validateAndSerialize(value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalMapContainsValueRequest(m.name, m.txn.txnID, m.txn.threadID, valueData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalMapContainsValueResponse(response), nil
+		}
+	}
+}
+
+// Delete removes the mapping for the given key.
+// Unlike Remove, Delete does not return the old value.
+func (m *TransactionalMap) Delete(ctx context.Context, key interface{}) error {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return err
+	} else {
+		request := codec.EncodeTransactionalMapDeleteRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		_, err := m.invoke(ctx, request)
+		return err
+	}
+}
+
+// Get returns the value for the given key, or nil if the map does not contain the key.
+func (m *TransactionalMap) Get(ctx context.Context, key interface{}) (interface{}, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapGetRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeTransactionalMapGetResponse(response))
+		}
+	}
+}
+
+// GetForUpdate locks the given key and returns its value.
+// The lock is released when the transaction is committed or rolled back.
+func (m *TransactionalMap) GetForUpdate(ctx context.Context, key interface{}) (interface{}, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapGetForUpdateRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeTransactionalMapGetForUpdateResponse(response))
+		}
+	}
+}
+
+// GetKeySet returns the keys contained in the map.
+func (m *TransactionalMap) GetKeySet(ctx context.Context) ([]interface{}, error) {
+	request := codec.EncodeTransactionalMapKeySetRequest(m.name, m.txn.txnID, m.txn.threadID)
+	if response, err := m.invoke(ctx, request); err != nil {
+		return nil, err
+	} else {
+		return m.convertToObjects(codec.DecodeTransactionalMapKeySetResponse(response))
+	}
+}
+
+// GetKeySetWithPredicate returns the keys of the entries which satisfy the given predicate.
+func (m *TransactionalMap) GetKeySetWithPredicate(ctx context.Context, pred predicate.Predicate) ([]interface{}, error) {
+	if predicateData, err := m.validateAndSerializePredicate(pred); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapKeySetWithPredicateRequest(m.name, m.txn.txnID, m.txn.threadID, predicateData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObjects(codec.DecodeTransactionalMapKeySetWithPredicateResponse(response))
+		}
+	}
+}
+
+// GetValues returns the values contained in the map.
+func (m *TransactionalMap) GetValues(ctx context.Context) ([]interface{}, error) {
+	request := codec.EncodeTransactionalMapValuesRequest(m.name, m.txn.txnID, m.txn.threadID)
+	if response, err := m.invoke(ctx, request); err != nil {
+		return nil, err
+	} else {
+		return m.convertToObjects(codec.DecodeTransactionalMapValuesResponse(response))
+	}
+}
+
+// GetValuesWithPredicate returns the values of the entries which satisfy the given predicate.
+func (m *TransactionalMap) GetValuesWithPredicate(ctx context.Context, pred predicate.Predicate) ([]interface{}, error) {
+	if predicateData, err := m.validateAndSerializePredicate(pred); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapValuesWithPredicateRequest(m.name, m.txn.txnID, m.txn.threadID, predicateData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObjects(codec.DecodeTransactionalMapValuesWithPredicateResponse(response))
+		}
+	}
+}
+
+// IsEmpty returns true if the map does not contain any entries.
+func (m *TransactionalMap) IsEmpty(ctx context.Context) (bool, error) {
+	request := codec.EncodeTransactionalMapIsEmptyRequest(m.name, m.txn.txnID, m.txn.threadID)
+	if response, err := m.invoke(ctx, request); err != nil {
+		return false, err
+	} else {
+		return codec.DecodeTransactionalMapIsEmptyResponse(response), nil
+	}
+}
+
+// Put sets the value for the given key and returns the old value.
+func (m *TransactionalMap) Put(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	return m.putWithTTL(ctx, key, value, ttlUnset)
+}
+
+// PutWithTTL sets the value for the given key and returns the old value.
+// The entry expires and gets evicted after the ttl.
+func (m *TransactionalMap) PutWithTTL(ctx context.Context, key interface{}, value interface{}, ttl time.Duration) (interface{}, error) {
+	return m.putWithTTL(ctx, key, value, ttl.Milliseconds())
+}
+
+// PutIfAbsent sets the value for the given key if the key does not exist and returns the current value.
+func (m *TransactionalMap) PutIfAbsent(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapPutIfAbsentRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, valueData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeTransactionalMapPutIfAbsentResponse(response))
+		}
+	}
+}
+
+// Remove removes the mapping for the given key and returns its value.
+func (m *TransactionalMap) Remove(ctx context.Context, key interface{}) (interface{}, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapRemoveRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeTransactionalMapRemoveResponse(response))
+		}
+	}
+}
+
+// RemoveIfSame removes the mapping for the given key only if it is mapped to the given value.
+// Returns true if the entry is removed.
+func (m *TransactionalMap) RemoveIfSame(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalMapRemoveIfSameRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, valueData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalMapRemoveIfSameResponse(response), nil
+		}
+	}
+}
+
+// Replace replaces the value of the given key only if the key exists and returns the old value.
+func (m *TransactionalMap) Replace(ctx context.Context, key interface{}, value interface{}) (interface{}, error) {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapReplaceRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, valueData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeTransactionalMapReplaceResponse(response))
+		}
+	}
+}
+
+// ReplaceIfSame replaces the value of the given key only if it is mapped to oldValue.
+// Returns true if the value is replaced.
+func (m *TransactionalMap) ReplaceIfSame(ctx context.Context, key interface{}, oldValue interface{}, newValue interface{}) (bool, error) {
+	if keyData, oldValueData, newValueData, err := m.validateAndSerialize3(key, oldValue, newValue); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalMapReplaceIfSameRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, oldValueData, newValueData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalMapReplaceIfSameResponse(response), nil
+		}
+	}
+}
+
+// Set sets the value for the given key.
+// Unlike Put, Set does not return the old value.
+func (m *TransactionalMap) Set(ctx context.Context, key interface{}, value interface{}) error {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return err
+	} else {
+		request := codec.EncodeTransactionalMapSetRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, valueData)
+		_, err := m.invoke(ctx, request)
+		return err
+	}
+}
+
+// Size returns the number of entries in the map.
+func (m *TransactionalMap) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalMapSizeRequest(m.name, m.txn.txnID, m.txn.threadID)
+	if response, err := m.invoke(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return int(codec.DecodeTransactionalMapSizeResponse(response)), nil
+	}
+}
+
+func (m *TransactionalMap) putWithTTL(ctx context.Context, key interface{}, value interface{}, ttl int64) (interface{}, error) {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMapPutRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, valueData, ttl)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObject(codec.DecodeTransactionalMapPutResponse(response))
+		}
+	}
+}
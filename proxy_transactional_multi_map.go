@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalMultiMap is the transactional counterpart of MultiMap.
+The changes made through a TransactionalMultiMap are visible to others only after the transaction is committed.
+
+Use TransactionContext.GetMultiMap to create a TransactionalMultiMap instance.
+*/
+type TransactionalMultiMap struct {
+	*transactionalProxy
+}
+
+// Get returns the values of the given key.
+func (m *TransactionalMultiMap) Get(ctx context.Context, key interface{}) ([]interface{}, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMultiMapGetRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObjects(codec.DecodeTransactionalMultiMapGetResponse(response))
+		}
+	}
+}
+
+// Put adds the given value to the values of the given key.
+// Returns true if the multi-map changed.
+func (m *TransactionalMultiMap) Put(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalMultiMapPutRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, valueData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalMultiMapPutResponse(response), nil
+		}
+	}
+}
+
+// Remove removes all values of the given key and returns them.
+func (m *TransactionalMultiMap) Remove(ctx context.Context, key interface{}) ([]interface{}, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return nil, err
+	} else {
+		request := codec.EncodeTransactionalMultiMapRemoveRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return nil, err
+		} else {
+			return m.convertToObjects(codec.DecodeTransactionalMultiMapRemoveResponse(response))
+		}
+	}
+}
+
+// RemoveEntry removes the given value from the values of the given key.
+// Returns true if the entry is removed.
+func (m *TransactionalMultiMap) RemoveEntry(ctx context.Context, key interface{}, value interface{}) (bool, error) {
+	if keyData, valueData, err := m.validateAndSerialize2(key, value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalMultiMapRemoveEntryRequest(m.name, m.txn.txnID, m.txn.threadID, keyData, valueData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalMultiMapRemoveEntryResponse(response), nil
+		}
+	}
+}
+
+// Size returns the number of key-value pairs in the multi-map.
+func (m *TransactionalMultiMap) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalMultiMapSizeRequest(m.name, m.txn.txnID, m.txn.threadID)
+	if response, err := m.invoke(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return int(codec.DecodeTransactionalMultiMapSizeResponse(response)), nil
+	}
+}
+
+// ValueCount returns the number of values of the given key.
+func (m *TransactionalMultiMap) ValueCount(ctx context.Context, key interface{}) (int, error) {
+	if keyData, err := m.validateAndSerialize(key); err != nil {
+		return 0, err
+	} else {
+		request := codec.EncodeTransactionalMultiMapValueCountRequest(m.name, m.txn.txnID, m.txn.threadID, keyData)
+		if response, err := m.invoke(ctx, request); err != nil {
+			return 0, err
+		} else {
+			return int(codec.DecodeTransactionalMultiMapValueCountResponse(response)), nil
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalQueue is the transactional counterpart of Queue.
+The items added to a TransactionalQueue are visible to others only after the transaction is committed,
+and the items removed from it are put back if the transaction is rolled back.
+
+Use TransactionContext.GetQueue to create a TransactionalQueue instance.
+*/
+type TransactionalQueue struct {
+	*transactionalProxy
+}
+
+// Add adds the given item to the queue if there is available space.
+// Returns true if the item is added.
+func (q *TransactionalQueue) Add(ctx context.Context, value interface{}) (bool, error) {
+	return q.add(ctx, value, 0)
+}
+
+// AddWithTimeout adds the given item to the queue, waiting up to the given timeout for space to become available.
+// Returns true if the item is added.
+func (q *TransactionalQueue) AddWithTimeout(ctx context.Context, value interface{}, timeout time.Duration) (bool, error) {
+	return q.add(ctx, value, timeout.Milliseconds())
+}
+
+// Peek retrieves the head of the queue without removing it.
+// Returns nil if the queue is empty.
+func (q *TransactionalQueue) Peek(ctx context.Context) (interface{}, error) {
+	return q.peek(ctx, 0)
+}
+
+// PeekWithTimeout retrieves the head of the queue without removing it, waiting up to the given timeout for an item.
+// Returns nil if the queue is still empty after the timeout.
+func (q *TransactionalQueue) PeekWithTimeout(ctx context.Context, timeout time.Duration) (interface{}, error) {
+	return q.peek(ctx, timeout.Milliseconds())
+}
+
+// Poll retrieves and removes the head of the queue.
+// Returns nil if the queue is empty.
+func (q *TransactionalQueue) Poll(ctx context.Context) (interface{}, error) {
+	return q.poll(ctx, 0)
+}
+
+// PollWithTimeout retrieves and removes the head of the queue, waiting up to the given timeout for an item.
+// Returns nil if the queue is still empty after the timeout.
+func (q *TransactionalQueue) PollWithTimeout(ctx context.Context, timeout time.Duration) (interface{}, error) {
+	return q.poll(ctx, timeout.Milliseconds())
+}
+
+// Size returns the number of items in the queue.
+func (q *TransactionalQueue) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalQueueSizeRequest(q.name, q.txn.txnID, q.txn.threadID)
+	if response, err := q.invoke(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return int(codec.DecodeTransactionalQueueSizeResponse(response)), nil
+	}
+}
+
+// Take retrieves and removes the head of the queue, waiting until an item becomes available.
+func (q *TransactionalQueue) Take(ctx context.Context) (interface{}, error) {
+	request := codec.EncodeTransactionalQueueTakeRequest(q.name, q.txn.txnID, q.txn.threadID)
+	if response, err := q.invoke(ctx, request); err != nil {
+		return nil, err
+	} else {
+		return q.convertToObject(codec.DecodeTransactionalQueueTakeResponse(response))
+	}
+}
+
+func (q *TransactionalQueue) add(ctx context.Context, value interface{}, timeout int64) (bool, error) {
+	if valueData, err := q.validateAndSerialize(value); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalQueueOfferRequest(q.name, q.txn.txnID, q.txn.threadID, valueData, timeout)
+		if response, err := q.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalQueueOfferResponse(response), nil
+		}
+	}
+}
+
+func (q *TransactionalQueue) peek(ctx context.Context, timeout int64) (interface{}, error) {
+	request := codec.EncodeTransactionalQueuePeekRequest(q.name, q.txn.txnID, q.txn.threadID, timeout)
+	if response, err := q.invoke(ctx, request); err != nil {
+		return nil, err
+	} else {
+		return q.convertToObject(codec.DecodeTransactionalQueuePeekResponse(response))
+	}
+}
+
+func (q *TransactionalQueue) poll(ctx context.Context, timeout int64) (interface{}, error) {
+	request := codec.EncodeTransactionalQueuePollRequest(q.name, q.txn.txnID, q.txn.threadID, timeout)
+	if response, err := q.invoke(ctx, request); err != nil {
+		return nil, err
+	} else {
+		return q.convertToObject(codec.DecodeTransactionalQueuePollResponse(response))
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+)
+
+/*
+TransactionalSet is the transactional counterpart of Set.
+The changes made through a TransactionalSet are visible to others only after the transaction is committed.
+
+Use TransactionContext.GetSet to create a TransactionalSet instance.
+*/
+type TransactionalSet struct {
+	*transactionalProxy
+}
+
+// Add adds the given item to the set.
+// Returns true if the set changed.
+func (s *TransactionalSet) Add(ctx context.Context, item interface{}) (bool, error) {
+	if itemData, err := s.validateAndSerialize(item); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalSetAddRequest(s.name, s.txn.txnID, s.txn.threadID, itemData)
+		if response, err := s.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalSetAddResponse(response), nil
+		}
+	}
+}
+
+// Remove removes the given item from the set.
+// Returns true if the set changed.
+func (s *TransactionalSet) Remove(ctx context.Context, item interface{}) (bool, error) {
+	if itemData, err := s.validateAndSerialize(item); err != nil {
+		return false, err
+	} else {
+		request := codec.EncodeTransactionalSetRemoveRequest(s.name, s.txn.txnID, s.txn.threadID, itemData)
+		if response, err := s.invoke(ctx, request); err != nil {
+			return false, err
+		} else {
+			return codec.DecodeTransactionalSetRemoveResponse(response), nil
+		}
+	}
+}
+
+// Size returns the number of items in the set.
+func (s *TransactionalSet) Size(ctx context.Context) (int, error) {
+	request := codec.EncodeTransactionalSetSizeRequest(s.name, s.txn.txnID, s.txn.threadID)
+	if response, err := s.invoke(ctx, request); err != nil {
+		return 0, err
+	} else {
+		return int(codec.DecodeTransactionalSetSizeResponse(response)), nil
+	}
+}
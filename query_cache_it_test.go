@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/predicate"
+)
+
+func TestQueryCache_InitialEntries(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		for i := int32(0); i < 10; i++ {
+			it.MustValue(m.Put(ctx, i, i*10))
+		}
+		qc, err := m.GetQueryCache(ctx, "query-cache", predicate.GreaterOrEqual("this", int32(50)), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer qc.Destroy(ctx)
+		assert.Equal(t, 5, it.MustValue(qc.Size(ctx)))
+		assert.Equal(t, int32(70), it.MustValue(qc.Get(ctx, int32(7))))
+		assert.Equal(t, nil, it.MustValue(qc.Get(ctx, int32(2))))
+		assert.Equal(t, true, it.MustValue(qc.ContainsKey(ctx, int32(5))))
+		assert.Equal(t, []int32{5, 6, 7, 8, 9}, sortedInt32s(it.MustValue(qc.GetKeySet(ctx)).([]interface{})))
+		values := it.MustValue(qc.GetValuesWithFilter(ctx, func(key, value interface{}) bool {
+			return value.(int32) > 70
+		})).([]interface{})
+		assert.Equal(t, []int32{80, 90}, sortedInt32s(values))
+		// the same query cache is returned for the same name
+		assert.Same(t, qc, it.MustValue(m.GetQueryCache(ctx, "query-cache", nil, false)))
+	})
+}
+
+func TestQueryCache_KeysOnly(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "v1"))
+		qc, err := m.GetQueryCache(ctx, "query-cache", nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer qc.Destroy(ctx)
+		assert.Equal(t, true, it.MustValue(qc.ContainsKey(ctx, "k1")))
+		assert.Equal(t, nil, it.MustValue(qc.Get(ctx, "k1")))
+	})
+}
+
+func TestQueryCache_ContinuousUpdates(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		qc, err := m.GetQueryCache(ctx, "query-cache", predicate.Equal("this", "OPEN"), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer qc.Destroy(ctx)
+		mu := &sync.Mutex{}
+		events := map[hz.EntryEventType]int{}
+		it.MustValue(qc.AddEntryListener(ctx, func(event *hz.EntryNotified) {
+			mu.Lock()
+			events[event.EventType]++
+			mu.Unlock()
+		}))
+		it.Must(m.Set(ctx, "order-1", "OPEN"))
+		it.Must(m.Set(ctx, "order-2", "OPEN"))
+		it.Must(m.Set(ctx, "order-3", "CLOSED"))
+		it.Eventually(t, func() bool {
+			return it.MustValue(qc.Size(ctx)) == 2
+		})
+		// the entry does not match the predicate anymore
+		it.Must(m.Set(ctx, "order-1", "CLOSED"))
+		it.MustValue(m.Remove(ctx, "order-2"))
+		it.Must(m.Set(ctx, "order-3", "OPEN"))
+		it.Eventually(t, func() bool {
+			keys := it.MustValue(qc.GetKeySet(ctx)).([]interface{})
+			return len(keys) == 1 && keys[0] == "order-3"
+		})
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return events[hz.EntryAdded] == 3 && events[hz.EntryRemoved] == 2
+		})
+	})
+}
+
+func TestQueryCache_ClearThenPut(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const entryCount = 100
+		for i := int32(0); i < entryCount; i++ {
+			it.MustValue(m.Put(ctx, i, i))
+		}
+		qc, err := m.GetQueryCache(ctx, "query-cache", nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer qc.Destroy(ctx)
+		mu := &sync.Mutex{}
+		cleared := 0
+		it.MustValue(qc.AddEntryListener(ctx, func(event *hz.EntryNotified) {
+			if event.EventType == hz.EntryAllCleared {
+				mu.Lock()
+				cleared += event.NumberOfAffectedEntries
+				mu.Unlock()
+			}
+		}))
+		it.Must(m.Clear(ctx))
+		it.MustValue(m.Put(ctx, "key", "value"))
+		// the clear events of the partitions must not remove the entry put after clearing the map
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return cleared == entryCount
+		})
+		it.Eventually(t, func() bool {
+			return it.MustValue(qc.Get(ctx, "key")) == "value"
+		})
+		it.Never(t, func() bool {
+			return it.MustValue(qc.Get(ctx, "key")) != "value"
+		})
+		assert.Equal(t, 1, it.MustValue(qc.Size(ctx)))
+	})
+}
+
+func TestQueryCache_UpdatesDuringCreation(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		const entryCount = 1000
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := int32(0); i < entryCount; i++ {
+				it.Must(m.Set(ctx, i, i))
+			}
+		}()
+		qc, err := m.GetQueryCache(ctx, "query-cache", nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer qc.Destroy(ctx)
+		wg.Wait()
+		// the events sent while the query cache was being created must not be dropped
+		it.Eventually(t, func() bool {
+			return it.MustValue(qc.Size(ctx)) == entryCount
+		})
+	})
+}
+
+func TestQueryCache_RecoverLostEvents(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		qc, err := m.GetQueryCache(ctx, "query-cache", nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer qc.Destroy(ctx)
+		it.Must(m.Set(ctx, "key", "value-1"))
+		it.Eventually(t, func() bool {
+			return it.MustValue(qc.Get(ctx, "key")) == "value-1"
+		})
+		it.Must(hz.LoseQueryCacheEvent(qc, "key"))
+		it.Must(m.Set(ctx, "key", "value-2"))
+		// the lost event is sent again, followed by the new one
+		it.Eventually(t, func() bool {
+			return it.MustValue(qc.Get(ctx, "key")) == "value-2"
+		})
+		assert.Equal(t, 1, it.MustValue(qc.Size(ctx)))
+	})
+}
+
+func TestQueryCache_Destroy(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "v1"))
+		qc := it.MustValue(m.GetQueryCache(ctx, "query-cache", nil, true)).(*hz.QueryCache)
+		it.Must(qc.Destroy(ctx))
+		assert.Equal(t, 0, it.MustValue(qc.Size(ctx)))
+		// a new query cache is created after the old one is destroyed
+		qc2 := it.MustValue(m.GetQueryCache(ctx, "query-cache", nil, true)).(*hz.QueryCache)
+		defer qc2.Destroy(ctx)
+		assert.NotSame(t, qc, qc2)
+		assert.Equal(t, 1, it.MustValue(qc2.Size(ctx)))
+	})
+}
+
+func TestQueryCache_ConcurrentCreation(t *testing.T) {
+	it.MapTester(t, func(t *testing.T, m *hz.Map) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k1", "v1"))
+		const count = 10
+		caches := make([]*hz.QueryCache, count)
+		wg := &sync.WaitGroup{}
+		wg.Add(count)
+		for i := 0; i < count; i++ {
+			go func(i int) {
+				defer wg.Done()
+				caches[i] = it.MustValue(m.GetQueryCache(ctx, "query-cache", nil, true)).(*hz.QueryCache)
+			}(i)
+		}
+		wg.Wait()
+		defer caches[0].Destroy(ctx)
+		for _, qc := range caches[1:] {
+			assert.Same(t, caches[0], qc)
+		}
+		assert.Equal(t, 1, it.MustValue(caches[0].Size(ctx)))
+	})
+}
+
+func sortedInt32s(values []interface{}) []int32 {
+	r := make([]int32, len(values))
+	for i, v := range values {
+		r[i] = v.(int32)
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i] < r[j] })
+	return r
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestReliableTopic_Publish(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		handlerValue := atomic.Value{}
+		handlerValue.Store("base-value")
+		subscriptionID, err := tp.AddMessageListener(context.Background(), func(event *hz.MessagePublished) {
+			handlerValue.Store(event.Value)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = tp.Publish(context.Background(), "value1"); err != nil {
+			t.Fatal(err)
+		}
+		it.Eventually(t, func() bool { return handlerValue.Load() == "value1" })
+		if err := tp.RemoveListener(context.Background(), subscriptionID); err != nil {
+			t.Fatal(err)
+		}
+		handlerValue.Store("base-value")
+		if err = tp.Publish(context.Background(), "value2"); err != nil {
+			t.Fatal(err)
+		}
+		it.Never(t, func() bool { return handlerValue.Load() != "base-value" })
+	})
+}
+
+func TestReliableTopic_PublishAllInOrder(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		var mu sync.Mutex
+		var values []interface{}
+		config := hz.NewReliableMessageListenerConfig()
+		config.BatchSize = 2
+		_, err := tp.AddMessageListenerWithConfig(context.Background(), config, func(event *hz.MessagePublished) {
+			mu.Lock()
+			values = append(values, event.Value)
+			mu.Unlock()
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		target := []interface{}{"v1", "v2", "v3", "v4", "v5"}
+		if err = tp.PublishAll(context.Background(), target...); err != nil {
+			t.Fatal(err)
+		}
+		it.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(values) == len(target)
+		})
+		mu.Lock()
+		assert.Equal(t, target, values)
+		mu.Unlock()
+	})
+}
+
+func TestReliableTopic_InitialSequence(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		// messages published before the listener is added are received, since they are stored in the ringbuffer
+		if err := tp.PublishAll(context.Background(), "v1", "v2", "v3"); err != nil {
+			t.Fatal(err)
+		}
+		callCount := int32(0)
+		config := hz.NewReliableMessageListenerConfig()
+		config.InitialSequence = 1
+		_, err := tp.AddMessageListenerWithConfig(context.Background(), config, func(event *hz.MessagePublished) {
+			atomic.AddInt32(&callCount, 1)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.Eventually(t, func() bool { return atomic.LoadInt32(&callCount) == 2 })
+		it.Never(t, func() bool { return atomic.LoadInt32(&callCount) != 2 })
+	})
+}
+
+func TestReliableTopic_InvalidBatchSize(t *testing.T) {
+	it.ReliableTopicTester(t, func(t *testing.T, tp *hz.ReliableTopic) {
+		config := hz.NewReliableMessageListenerConfig()
+		config.BatchSize = -1
+		_, err := tp.AddMessageListenerWithConfig(context.Background(), config, func(event *hz.MessagePublished) {})
+		if !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
@@ -25,6 +25,7 @@ import (
 
 	hz "github.com/hazelcast/hazelcast-go-client"
 	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/nearcache"
 	"github.com/hazelcast/hazelcast-go-client/predicate"
 	"github.com/hazelcast/hazelcast-go-client/types"
 )
@@ -250,3 +251,58 @@ func TestReplicatedMap_AddEntryListenerToKeyWithPredicate(t *testing.T) {
 		it.Eventually(t, func() bool { return atomic.LoadInt32(&callCount) == targetCallCount }, "Call count ", callCount)
 	})
 }
+
+func TestReplicatedMap_NearCache(t *testing.T) {
+	mapName := it.NewUniqueObjectName("replicated-map", "near-cache")
+	var otherConfig hz.Config
+	cbCallback := func(config *hz.Config) {
+		otherConfig = config.Clone()
+		it.Must(config.AddNearCache(mapName, nearcache.Config{}))
+	}
+	makeMapName := func() string {
+		return mapName
+	}
+	it.ReplicatedMapTesterWithConfigAndName(t, makeMapName, cbCallback, func(t *testing.T, m *hz.ReplicatedMap) {
+		ctx := context.Background()
+		it.MustValue(m.Put(ctx, "k", "v1"))
+		it.AssertEquals(t, "v1", it.MustValue(m.Get(ctx, "k")))
+		it.AssertEquals(t, "v1", it.MustValue(m.Get(ctx, "k")))
+		it.MustValue(m.Put(ctx, "k", "v2"))
+		it.AssertEquals(t, "v2", it.MustValue(m.Get(ctx, "k")))
+		other := it.MustClient(hz.StartNewClientWithConfig(ctx, otherConfig))
+		defer other.Shutdown(ctx)
+		otherMap, err := other.GetReplicatedMap(ctx, mapName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		it.MustValue(otherMap.Put(ctx, "k", "v3"))
+		it.Eventually(t, func() bool {
+			return it.MustValue(m.Get(ctx, "k")) == "v3"
+		})
+		it.Must(otherMap.Clear(ctx))
+		it.Eventually(t, func() bool {
+			return it.MustValue(m.Get(ctx, "k")) == nil
+		})
+	})
+}
+
+func TestReplicatedMap_NearCacheConfigSharedWithMap(t *testing.T) {
+	name := it.NewUniqueObjectName("replicated-map", "near-cache-shared")
+	cbCallback := func(config *hz.Config) {
+		it.Must(config.AddNearCache(name, nearcache.Config{}))
+	}
+	it.TesterWithConfigBuilder(t, cbCallback, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		m := it.MustValue(client.GetMap(ctx, name)).(*hz.Map)
+		defer m.Destroy(ctx)
+		rm := it.MustValue(client.GetReplicatedMap(ctx, name)).(*hz.ReplicatedMap)
+		defer rm.Destroy(ctx)
+		it.Must(m.Set(ctx, "k", "map-value"))
+		it.MustValue(rm.Put(ctx, "k", "replicated-map-value"))
+		// the Map and the ReplicatedMap share the configuration, but each of them has its own Near Cache
+		for i := 0; i < 2; i++ {
+			it.AssertEquals(t, "map-value", it.MustValue(m.Get(ctx, "k")))
+			it.AssertEquals(t, "replicated-map-value", it.MustValue(rm.Get(ctx, "k")))
+		}
+	})
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+const ringbufferDefaultCapacity = int64(10000)
+
+func TestRingbuffer_Add(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		assert.Equal(t, int64(0), it.MustValue(rb.Add(ctx, "value-1", hz.OverflowPolicyOverwrite)))
+		assert.Equal(t, int64(1), it.MustValue(rb.Add(ctx, "value-2", hz.OverflowPolicyFail)))
+		assert.Equal(t, "value-1", it.MustValue(rb.ReadOne(ctx, 0)))
+		assert.Equal(t, "value-2", it.MustValue(rb.ReadOne(ctx, 1)))
+	})
+}
+
+func TestRingbuffer_AddAll(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		assert.Equal(t, int64(2), it.MustValue(rb.AddAll(ctx, hz.OverflowPolicyOverwrite, "v1", "v2", "v3")))
+		assert.Equal(t, int64(3), it.MustValue(rb.Size(ctx)))
+		if _, err := rb.AddAll(ctx, hz.OverflowPolicyOverwrite); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func TestRingbuffer_Sequences(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		assert.Equal(t, int64(-1), it.MustValue(rb.TailSequence(ctx)))
+		assert.Equal(t, int64(0), it.MustValue(rb.HeadSequence(ctx)))
+		it.MustValue(rb.AddAll(ctx, hz.OverflowPolicyOverwrite, "v1", "v2", "v3"))
+		assert.Equal(t, int64(2), it.MustValue(rb.TailSequence(ctx)))
+		assert.Equal(t, int64(0), it.MustValue(rb.HeadSequence(ctx)))
+	})
+}
+
+func TestRingbuffer_CapacityAndSize(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		assert.Equal(t, ringbufferDefaultCapacity, it.MustValue(rb.Capacity(ctx)))
+		assert.Equal(t, int64(0), it.MustValue(rb.Size(ctx)))
+		assert.Equal(t, ringbufferDefaultCapacity, it.MustValue(rb.RemainingCapacity(ctx)))
+		it.MustValue(rb.Add(ctx, "value", hz.OverflowPolicyOverwrite))
+		assert.Equal(t, int64(1), it.MustValue(rb.Size(ctx)))
+	})
+}
+
+func TestRingbuffer_ReadMany(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		it.MustValue(rb.AddAll(ctx, hz.OverflowPolicyOverwrite, "v1", "v2", "v3", "v4"))
+		result, err := rb.ReadMany(ctx, 1, 1, 2, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []interface{}{"v2", "v3"}, result.Items)
+		assert.Equal(t, []int64{1, 2}, result.ItemSequences)
+		assert.Equal(t, 2, result.ReadCount)
+		assert.Equal(t, int64(3), result.NextSequence)
+	})
+}
+
+func TestRingbuffer_ReadManyInvalidArguments(t *testing.T) {
+	it.RingbufferTester(t, func(t *testing.T, rb *hz.Ringbuffer) {
+		ctx := context.Background()
+		testCases := []struct {
+			name     string
+			start    int64
+			minCount int
+			maxCount int
+		}{
+			{name: "negative sequence", start: -1, minCount: 0, maxCount: 1},
+			{name: "negative min count", start: 0, minCount: -1, maxCount: 1},
+			{name: "min count larger than max count", start: 0, minCount: 2, maxCount: 1},
+			{name: "max count too large", start: 0, minCount: 0, maxCount: 1001},
+		}
+		for _, tc := range testCases {
+			if _, err := rb.ReadMany(ctx, tc.start, tc.minCount, tc.maxCount, nil); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+				t.Fatalf("%s: expected illegal argument error, got: %v", tc.name, err)
+			}
+		}
+	})
+}
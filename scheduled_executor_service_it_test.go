@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestScheduledExecutorService_ScheduleInvalidTask(t *testing.T) {
+	scheduledExecutorServiceTester(t, func(t *testing.T, s *hz.ScheduledExecutorService) {
+		ctx := context.Background()
+		if _, err := s.Schedule(ctx, "not-a-callable", time.Second); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+		if _, err := s.ScheduleOnAllMembers(ctx, "not-a-callable", time.Second); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func TestScheduledExecutorService_ScheduleInvalidDelay(t *testing.T) {
+	scheduledExecutorServiceTester(t, func(t *testing.T, s *hz.ScheduledExecutorService) {
+		ctx := context.Background()
+		if _, err := s.Schedule(ctx, &SimpleEntryProcessor{}, -time.Second); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+		if _, err := s.ScheduleAtFixedRate(ctx, &SimpleEntryProcessor{}, time.Second, 0); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func TestScheduledExecutorService_GetScheduledFuture(t *testing.T) {
+	scheduledExecutorServiceTester(t, func(t *testing.T, s *hz.ScheduledExecutorService) {
+		handler := hz.ScheduledTaskHandler{
+			SchedulerName: "scheduler",
+			TaskName:      "task",
+			PartitionID:   1,
+		}
+		f := s.GetScheduledFuture(handler)
+		assert.Equal(t, handler, f.Handler())
+		h, err := hz.NewScheduledTaskHandlerFromURN(f.Handler().URN())
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, handler, h)
+	})
+}
+
+func TestScheduledExecutorService_Shutdown(t *testing.T) {
+	scheduledExecutorServiceTester(t, func(t *testing.T, s *hz.ScheduledExecutorService) {
+		it.Must(s.Shutdown(context.Background()))
+	})
+}
+
+func scheduledExecutorServiceTester(t *testing.T, f func(t *testing.T, s *hz.ScheduledExecutorService)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		s, err := client.GetScheduledExecutorService(ctx, it.NewUniqueObjectName("scheduled-executor"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := s.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy scheduled executor service: %s", err.Error())
+			}
+		}()
+		f(t, s)
+	})
+}
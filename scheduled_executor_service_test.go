@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestScheduledTaskHandler_URN(t *testing.T) {
+	testCases := []struct {
+		name    string
+		urn     string
+		handler hz.ScheduledTaskHandler
+	}{
+		{
+			name: "partition",
+			urn:  "urn:hzScheduledTaskHandler:-\x0042\x00scheduler\x00task",
+			handler: hz.ScheduledTaskHandler{
+				SchedulerName: "scheduler",
+				TaskName:      "task",
+				PartitionID:   42,
+			},
+		},
+		{
+			name: "member",
+			urn:  "urn:hzScheduledTaskHandler:01234567-89ab-cdef-0123-456789abcdef\x00-1\x00scheduler\x00task",
+			handler: hz.ScheduledTaskHandler{
+				SchedulerName: "scheduler",
+				TaskName:      "task",
+				MemberUUID:    types.NewUUIDWith(0x0123456789abcdef, 0x0123456789abcdef),
+				PartitionID:   -1,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.urn, tc.handler.URN())
+			handler, err := hz.NewScheduledTaskHandlerFromURN(tc.urn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, tc.handler, handler)
+		})
+	}
+}
+
+func TestNewScheduledTaskHandlerFromURN_Invalid(t *testing.T) {
+	urns := []string{
+		"",
+		"urn:other:-\x000\x00scheduler\x00task",
+		"urn:hzScheduledTaskHandler:-\x000\x00scheduler",
+		"urn:hzScheduledTaskHandler:-\x00not-a-number\x00scheduler\x00task",
+		"urn:hzScheduledTaskHandler:not-a-uuid\x00-1\x00scheduler\x00task",
+	}
+	for _, urn := range urns {
+		if _, err := hz.NewScheduledTaskHandlerFromURN(urn); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error for %q, got: %v", urn, err)
+		}
+	}
+}
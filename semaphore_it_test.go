@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestSemaphore_Init(t *testing.T) {
+	semaphoreTester(t, "semaphore", func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		assert.Equal(t, true, it.MustValue(s.Init(ctx, 5)))
+		assert.Equal(t, false, it.MustValue(s.Init(ctx, 10)))
+		assert.Equal(t, 5, it.MustValue(s.AvailablePermits(ctx)))
+		if _, err := s.Init(ctx, -1); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func TestSemaphore_AcquireRelease(t *testing.T) {
+	semaphoreTester(t, "semaphore", func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		it.MustValue(s.Init(ctx, 5))
+		it.Must(s.Acquire(ctx, 3))
+		assert.Equal(t, 2, it.MustValue(s.AvailablePermits(ctx)))
+		it.Must(s.Release(ctx, 2))
+		assert.Equal(t, 4, it.MustValue(s.AvailablePermits(ctx)))
+		it.Must(s.Release(ctx, 1))
+		assert.Equal(t, 5, it.MustValue(s.AvailablePermits(ctx)))
+		if err := s.Acquire(ctx, 0); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error, got: %v", err)
+		}
+	})
+}
+
+func TestSemaphore_TryAcquire(t *testing.T) {
+	semaphoreTester(t, "semaphore", func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		it.MustValue(s.Init(ctx, 2))
+		assert.Equal(t, true, it.MustValue(s.TryAcquire(ctx, 2, 0)))
+		assert.Equal(t, false, it.MustValue(s.TryAcquire(ctx, 1, 100*time.Millisecond)))
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			it.Must(s.Release(ctx, 1))
+		}()
+		assert.Equal(t, true, it.MustValue(s.TryAcquire(ctx, 1, 10*time.Second)))
+		it.Must(s.Release(ctx, 1))
+	})
+}
+
+func TestSemaphore_DrainChange(t *testing.T) {
+	semaphoreTester(t, "semaphore", func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		it.MustValue(s.Init(ctx, 5))
+		it.Must(s.Change(ctx, 3))
+		assert.Equal(t, 8, it.MustValue(s.AvailablePermits(ctx)))
+		it.Must(s.Change(ctx, -2))
+		assert.Equal(t, 6, it.MustValue(s.AvailablePermits(ctx)))
+		assert.Equal(t, 6, it.MustValue(s.Drain(ctx)))
+		assert.Equal(t, 0, it.MustValue(s.AvailablePermits(ctx)))
+		it.Must(s.Release(ctx, 6))
+		assert.Equal(t, 6, it.MustValue(s.AvailablePermits(ctx)))
+	})
+}
+
+func TestSemaphore_ReleaseWithoutAcquire(t *testing.T) {
+	semaphoreTester(t, "semaphore", func(t *testing.T, s *hz.Semaphore) {
+		ctx := context.Background()
+		it.MustValue(s.Init(ctx, 1))
+		// the default semaphores are session-aware, so only the acquired permits can be released
+		if err := s.Release(ctx, 1); !errors.Is(err, hzerrors.ErrIllegalState) {
+			t.Fatalf("expected illegal state error, got: %v", err)
+		}
+	})
+}
+
+func semaphoreTester(t *testing.T, prefix string, f func(t *testing.T, s *hz.Semaphore)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		s, err := client.CPSubsystem().GetSemaphore(ctx, it.NewUniqueObjectName(prefix))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := s.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy semaphore: %s", err.Error())
+			}
+		}()
+		f(t, s)
+	})
+}
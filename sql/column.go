@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import "fmt"
+
+// ColumnType is the SQL type of a column.
+type ColumnType int32
+
+const (
+	ColumnTypeVarchar               ColumnType = 0
+	ColumnTypeBoolean               ColumnType = 1
+	ColumnTypeTinyInt               ColumnType = 2
+	ColumnTypeSmallInt              ColumnType = 3
+	ColumnTypeInteger               ColumnType = 4
+	ColumnTypeBigInt                ColumnType = 5
+	ColumnTypeDecimal               ColumnType = 6
+	ColumnTypeReal                  ColumnType = 7
+	ColumnTypeDouble                ColumnType = 8
+	ColumnTypeDate                  ColumnType = 9
+	ColumnTypeTime                  ColumnType = 10
+	ColumnTypeTimestamp             ColumnType = 11
+	ColumnTypeTimestampWithTimeZone ColumnType = 12
+	ColumnTypeObject                ColumnType = 13
+	ColumnTypeNull                  ColumnType = 14
+	ColumnTypeJSON                  ColumnType = 15
+)
+
+// String returns the SQL name of the type.
+func (t ColumnType) String() string {
+	switch t {
+	case ColumnTypeVarchar:
+		return "VARCHAR"
+	case ColumnTypeBoolean:
+		return "BOOLEAN"
+	case ColumnTypeTinyInt:
+		return "TINYINT"
+	case ColumnTypeSmallInt:
+		return "SMALLINT"
+	case ColumnTypeInteger:
+		return "INTEGER"
+	case ColumnTypeBigInt:
+		return "BIGINT"
+	case ColumnTypeDecimal:
+		return "DECIMAL"
+	case ColumnTypeReal:
+		return "REAL"
+	case ColumnTypeDouble:
+		return "DOUBLE"
+	case ColumnTypeDate:
+		return "DATE"
+	case ColumnTypeTime:
+		return "TIME"
+	case ColumnTypeTimestamp:
+		return "TIMESTAMP"
+	case ColumnTypeTimestampWithTimeZone:
+		return "TIMESTAMP WITH TIME ZONE"
+	case ColumnTypeObject:
+		return "OBJECT"
+	case ColumnTypeNull:
+		return "NULL"
+	case ColumnTypeJSON:
+		return "JSON"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", int32(t))
+	}
+}
+
+// ColumnMetadata describes a column of the rows returned by a query.
+type ColumnMetadata struct {
+	Name     string
+	Type     ColumnType
+	Nullable bool
+}
+
+// RowMetadata describes the columns of the rows returned by a query.
+type RowMetadata interface {
+	// ColumnCount returns the number of columns.
+	ColumnCount() int
+	// Column returns the metadata of the column at the given index.
+	// Returns hzerrors.ErrIndexOutOfBounds if the index is not valid.
+	Column(index int) (ColumnMetadata, error)
+	// Columns returns the metadata of all columns.
+	Columns() []ColumnMetadata
+	// FindColumn returns the index of the column with the given name.
+	// Returns hzerrors.ErrIllegalArgument if there is no such column.
+	FindColumn(name string) (int, error)
+}
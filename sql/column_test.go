@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/sql"
+)
+
+func TestColumnType_String(t *testing.T) {
+	assert.Equal(t, "VARCHAR", sql.ColumnTypeVarchar.String())
+	assert.Equal(t, "TIMESTAMP WITH TIME ZONE", sql.ColumnTypeTimestampWithTimeZone.String())
+	assert.Equal(t, "JSON", sql.ColumnTypeJSON.String())
+	assert.Equal(t, "UNKNOWN(42)", sql.ColumnType(42).String())
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+Package sql contains the API for running SQL queries on the cluster.
+
+Running Queries
+
+SQL queries are run using the Service returned from Client.SQL:
+
+	result, err := client.SQL().Execute(ctx, "SELECT __key, this FROM employees WHERE age > ?", 30)
+	if err != nil {
+		// handle the error
+	}
+	defer result.Close(ctx)
+	for {
+		ok, err := result.HasNext(ctx)
+		if err != nil {
+			// handle the error
+		}
+		if !ok {
+			break
+		}
+		row, err := result.Next(ctx)
+		if err != nil {
+			// handle the error
+		}
+		name, err := row.GetString(1)
+		// ...
+	}
+
+The rows are fetched from the cluster page by page, the size of a page is set using Statement.CursorBufferSize.
+A query which does not return rows, such as INSERT or UPDATE, returns the number of affected rows using Result.UpdateCount.
+
+A map must have a mapping in order to be queried.
+See https://docs.hazelcast.com/hazelcast/latest/sql/mapping-to-maps.html for details.
+
+Type Mapping
+
+The SQL types are mapped onto Go types as follows:
+
+	VARCHAR                  string
+	BOOLEAN                  bool
+	TINYINT                  int8
+	SMALLINT                 int16
+	INTEGER                  int32
+	BIGINT                   int64
+	DECIMAL                  types.Decimal
+	REAL                     float32
+	DOUBLE                   float64
+	DATE                     time.Time (in the local time zone)
+	TIME                     time.Time (in the local time zone, the date part is 0000-01-01)
+	TIMESTAMP                time.Time (in the local time zone)
+	TIMESTAMP WITH TIME ZONE time.Time (with a fixed time zone)
+	OBJECT                   the deserialized value
+	NULL                     nil
+	JSON                     serialization.JSON
+
+The query parameters are serialized using the client serialization service, so any supported Go value can be used as a parameter.
+*/
+package sql
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+// ErrorCode identifies the cause of a SQL error.
+type ErrorCode int32
+
+const (
+	ErrorCodeGeneric               ErrorCode = -1
+	ErrorCodeConnectionProblem     ErrorCode = 1001
+	ErrorCodeCancelledByUser       ErrorCode = 1003
+	ErrorCodeTimeout               ErrorCode = 1004
+	ErrorCodePartitionDistribution ErrorCode = 1005
+	ErrorCodeMapDestroyed          ErrorCode = 1006
+	ErrorCodeMapLoadingInProgress  ErrorCode = 1007
+	ErrorCodeParsing               ErrorCode = 1008
+	ErrorCodeIndexInvalid          ErrorCode = 1009
+	ErrorCodeDataException         ErrorCode = 2000
+)
+
+// Error is returned when a query fails on the cluster.
+type Error struct {
+	// Message is the description of the error.
+	Message string
+	// Suggestion is the possible fix for the error, it may be blank.
+	Suggestion string
+	// OriginatingMemberID is the UUID of the member where the error occurred.
+	OriginatingMemberID types.UUID
+	// Code identifies the cause of the error.
+	Code ErrorCode
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("SQL error %d: %s", e.Code, e.Message)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import "context"
+
+/*
+Result is the result of a query.
+
+A query either returns rows or an update count, use IsRowSet to find out which one.
+The rows are iterated using HasNext and Next:
+
+	for {
+		ok, err := result.HasNext(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		row, err := result.Next(ctx)
+		// ...
+	}
+
+Result must be closed once it is not needed anymore, in order to release the resources on the cluster.
+Result is not thread-safe.
+*/
+type Result interface {
+	// RowMetadata returns the metadata of the rows.
+	// Returns hzerrors.ErrIllegalState if the result does not contain rows.
+	RowMetadata() (RowMetadata, error)
+	// IsRowSet returns true if the result contains rows, false if it contains an update count.
+	IsRowSet() bool
+	// UpdateCount returns the number of rows affected by the query, or -1 if the result contains rows.
+	UpdateCount() int64
+	// HasNext returns true if there are rows which are not returned by Next yet.
+	// It fetches the next page of rows from the cluster if necessary.
+	HasNext(ctx context.Context) (bool, error)
+	// Next returns the next row.
+	// Returns hzerrors.ErrNoSuchElement if there are no more rows.
+	Next(ctx context.Context) (Row, error)
+	// Close releases the resources of the query on the cluster.
+	// It is safe to call Close more than once.
+	Close(ctx context.Context) error
+}
+
+// Service runs SQL queries on the cluster.
+type Service interface {
+	// Execute runs the given SQL statement with the given parameters, using the default statement options.
+	Execute(ctx context.Context, statement string, params ...interface{}) (Result, error)
+	// ExecuteStatement runs the given statement.
+	ExecuteStatement(ctx context.Context, statement Statement) (Result, error)
+}
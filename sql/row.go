@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+/*
+Row is a row returned by a query.
+
+The typed getters return hzerrors.ErrClassCast if the value of the column does not have the requested type.
+Since nil cannot be converted to those types, use Get for the columns which may be NULL.
+*/
+type Row interface {
+	// Metadata returns the metadata of the columns of the row.
+	Metadata() RowMetadata
+	// Get returns the value of the column at the given index.
+	Get(index int) (interface{}, error)
+	// GetByColumnName returns the value of the column with the given name.
+	GetByColumnName(name string) (interface{}, error)
+	// GetString returns the value of a VARCHAR column.
+	GetString(index int) (string, error)
+	// GetBool returns the value of a BOOLEAN column.
+	GetBool(index int) (bool, error)
+	// GetInt8 returns the value of a TINYINT column.
+	GetInt8(index int) (int8, error)
+	// GetInt16 returns the value of a SMALLINT column.
+	GetInt16(index int) (int16, error)
+	// GetInt32 returns the value of an INTEGER column.
+	GetInt32(index int) (int32, error)
+	// GetInt64 returns the value of a BIGINT column.
+	GetInt64(index int) (int64, error)
+	// GetFloat32 returns the value of a REAL column.
+	GetFloat32(index int) (float32, error)
+	// GetFloat64 returns the value of a DOUBLE column.
+	GetFloat64(index int) (float64, error)
+	// GetDecimal returns the value of a DECIMAL column.
+	GetDecimal(index int) (types.Decimal, error)
+	// GetTime returns the value of a DATE, TIME, TIMESTAMP or TIMESTAMP WITH TIME ZONE column.
+	GetTime(index int) (time.Time, error)
+	// GetJSON returns the value of a JSON column.
+	GetJSON(index int) (serialization.JSON, error)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import "time"
+
+// DefaultCursorBufferSize is the default number of rows fetched from the cluster in a single page.
+const DefaultCursorBufferSize = 4096
+
+// ExpectedResultType is the type of the result expected from a statement.
+type ExpectedResultType byte
+
+const (
+	// ExpectedResultTypeAny allows the statement to return either rows or an update count.
+	ExpectedResultTypeAny ExpectedResultType = 0
+	// ExpectedResultTypeRows requires the statement to return rows.
+	ExpectedResultTypeRows ExpectedResultType = 1
+	// ExpectedResultTypeUpdateCount requires the statement to return an update count.
+	ExpectedResultTypeUpdateCount ExpectedResultType = 2
+)
+
+// Statement is an SQL statement with its parameters and options.
+type Statement struct {
+	// SQL is the SQL string of the statement.
+	SQL string
+	// Schema is the schema to resolve the object names in the statement.
+	// The default schema is used if it is blank.
+	Schema string
+	// Parameters are the values of the ? placeholders in the statement.
+	Parameters []interface{}
+	// Timeout is the execution timeout of the statement.
+	// The timeout configured on the members is used if it is zero, a negative value disables the timeout.
+	Timeout time.Duration
+	// CursorBufferSize is the number of rows fetched from the cluster in a single page.
+	// DefaultCursorBufferSize is used if it is zero.
+	CursorBufferSize int32
+	// ExpectedResultType is the type of the result expected from the statement.
+	ExpectedResultType ExpectedResultType
+}
+
+// NewStatement creates a statement with the given SQL string and parameters, and the default options.
+func NewStatement(sql string, params ...interface{}) Statement {
+	return Statement{
+		SQL:              sql,
+		Parameters:       params,
+		CursorBufferSize: DefaultCursorBufferSize,
+	}
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+	"github.com/hazelcast/hazelcast-go-client/sql"
+)
+
+func TestSQL_SelectWithPaging(t *testing.T) {
+	sqlMapTester(t, func(t *testing.T, client *hz.Client, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		for i := int32(0); i < 50; i++ {
+			it.Must(m.Set(ctx, i, fmt.Sprintf("value-%d", i)))
+		}
+		stmt := sql.NewStatement(fmt.Sprintf(`SELECT __key, this FROM "%s" WHERE __key >= ?`, mapName), int32(10))
+		// the rows are fetched in multiple pages
+		stmt.CursorBufferSize = 7
+		result, err := client.SQL().ExecuteStatement(ctx, stmt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer result.Close(ctx)
+		assert.True(t, result.IsRowSet())
+		assert.Equal(t, int64(-1), result.UpdateCount())
+		md := it.MustValue(result.RowMetadata()).(sql.RowMetadata)
+		assert.Equal(t, 2, md.ColumnCount())
+		assert.Equal(t, sql.ColumnMetadata{Name: "__key", Type: sql.ColumnTypeInteger, Nullable: true}, md.Columns()[0])
+		assert.Equal(t, sql.ColumnTypeVarchar, md.Columns()[1].Type)
+		var keys []int
+		for {
+			ok, err := result.HasNext(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				break
+			}
+			row, err := result.Next(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			key := it.MustValue(row.GetInt32(0)).(int32)
+			assert.Equal(t, fmt.Sprintf("value-%d", key), it.MustValue(row.GetByColumnName("this")))
+			keys = append(keys, int(key))
+		}
+		sort.Ints(keys)
+		target := make([]int, 40)
+		for i := range target {
+			target[i] = i + 10
+		}
+		assert.Equal(t, target, keys)
+		if _, err := result.Next(ctx); !errors.Is(err, hzerrors.ErrNoSuchElement) {
+			t.Fatalf("expected no such element error, got: %v", err)
+		}
+	})
+}
+
+func TestSQL_CloseBeforeLastPage(t *testing.T) {
+	sqlMapTester(t, func(t *testing.T, client *hz.Client, m *hz.Map, mapName string) {
+		ctx := context.Background()
+		for i := int32(0); i < 10; i++ {
+			it.Must(m.Set(ctx, i, i))
+		}
+		stmt := sql.NewStatement(fmt.Sprintf(`SELECT this FROM "%s"`, mapName))
+		stmt.CursorBufferSize = 2
+		result, err := client.SQL().ExecuteStatement(ctx, stmt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		row := it.MustValue(result.Next(ctx)).(sql.Row)
+		if _, err := row.GetString(0); !errors.Is(err, hzerrors.ErrClassCast) {
+			t.Fatalf("expected class cast error, got: %v", err)
+		}
+		it.Must(result.Close(ctx))
+		// closing again is a no-op
+		it.Must(result.Close(ctx))
+		if _, err := result.HasNext(ctx); !errors.Is(err, hzerrors.ErrIllegalState) {
+			t.Fatalf("expected illegal state error, got: %v", err)
+		}
+	})
+}
+
+func TestSQL_Error(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		_, err := client.SQL().Execute(context.Background(), "SELECT * FROM")
+		var sqlErr *sql.Error
+		if !errors.As(err, &sqlErr) {
+			t.Fatalf("expected SQL error, got: %v", err)
+		}
+		assert.Equal(t, sql.ErrorCodeParsing, sqlErr.Code)
+	})
+}
+
+func sqlMapTester(t *testing.T, f func(t *testing.T, client *hz.Client, m *hz.Map, mapName string)) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		mapName := it.NewUniqueObjectName("sql-map")
+		m, err := client.GetMap(ctx, mapName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := m.Destroy(ctx); err != nil {
+				t.Logf("test warning, could not destroy map: %s", err.Error())
+			}
+		}()
+		f(t, client, m, mapName)
+	})
+}
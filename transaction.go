@@ -0,0 +1,309 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/cb"
+	"github.com/hazelcast/hazelcast-go-client/internal/cluster"
+	ihzerrors "github.com/hazelcast/hazelcast-go-client/internal/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/codec"
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+const (
+	defaultTransactionTimeout    = 2 * time.Minute
+	defaultTransactionDurability = 1
+)
+
+const (
+	transactionStateNoTxn int32 = iota
+	transactionStateActive
+	transactionStateCommitted
+	transactionStateCommitFailed
+	transactionStateRolledBack
+)
+
+// TransactionType is the type of a transaction.
+type TransactionType int32
+
+const (
+	// TransactionTypeTwoPhase commits the transaction in two phases.
+	// The changes are first prepared on the members, which are replicated to the backups, before they are committed.
+	// So the transaction can be committed by a backup if the member which executes the commit dies.
+	TransactionTypeTwoPhase TransactionType = 1
+	// TransactionTypeOnePhase commits the transaction in a single phase.
+	// It is faster than TransactionTypeTwoPhase, but the system may be left in an inconsistent state if a member dies during the commit.
+	TransactionTypeOnePhase TransactionType = 2
+)
+
+// TransactionOptions contains the options for a transaction.
+// Use NewTransactionOptions to create options with the default values.
+type TransactionOptions struct {
+	// Timeout is the duration after which the transaction is rolled back if it is not committed.
+	// Defaults to 2 minutes.
+	Timeout time.Duration
+	// Durability is the number of backups of the transaction log.
+	// Defaults to 1.
+	Durability int32
+	// Type is the type of the transaction.
+	// Defaults to TransactionTypeTwoPhase.
+	Type TransactionType
+}
+
+// NewTransactionOptions creates TransactionOptions with the default values.
+func NewTransactionOptions() TransactionOptions {
+	return TransactionOptions{
+		Timeout:    defaultTransactionTimeout,
+		Durability: defaultTransactionDurability,
+		Type:       TransactionTypeTwoPhase,
+	}
+}
+
+func (o *TransactionOptions) Validate() error {
+	if o.Timeout < 0 {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("transaction timeout must be non-negative: %s", o.Timeout), nil)
+	}
+	if o.Timeout == 0 {
+		o.Timeout = defaultTransactionTimeout
+	}
+	if o.Durability < 0 {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("transaction durability must be non-negative: %d", o.Durability), nil)
+	}
+	if o.Type == 0 {
+		o.Type = TransactionTypeTwoPhase
+	} else if o.Type != TransactionTypeTwoPhase && o.Type != TransactionTypeOnePhase {
+		return ihzerrors.NewIllegalArgumentError(fmt.Sprintf("invalid transaction type: %d", o.Type), nil)
+	}
+	return nil
+}
+
+/*
+TransactionContext provides transactional access to the Map, Queue, List, Set and MultiMap data structures.
+
+The changes made through the transactional data structures are visible to others only after the transaction is committed,
+and they are discarded if the transaction is rolled back.
+All operations of a transaction are sent over the same connection.
+If that connection is closed, the transaction fails and it is rolled back on the cluster.
+
+	txn, err := client.NewTransactionContext(hazelcast.NewTransactionOptions())
+	err = txn.Begin(ctx)
+	m, err := txn.GetMap(ctx, "my-map")
+	q, err := txn.GetQueue(ctx, "my-queue")
+	value, err := q.Poll(ctx)
+	err = m.Set(ctx, "some-key", value)
+	// commit both changes atomically
+	err = txn.Commit(ctx)
+
+A TransactionContext can be used for a single transaction and it is not safe to be used by multiple goroutines concurrently.
+
+For details see https://docs.hazelcast.com/imdg/latest/transactions/creating-a-transaction-interface.html
+*/
+type TransactionContext struct {
+	startTime time.Time
+	invoker   *proxy
+	proxies   *proxyManager
+	conn      *cluster.Connection
+	mu        *sync.Mutex
+	options   TransactionOptions
+	threadID  int64
+	txnID     types.UUID
+	state     int32
+}
+
+func newTransactionContext(pm *proxyManager, conn *cluster.Connection, options TransactionOptions) *TransactionContext {
+	return &TransactionContext{
+		invoker:  pm.invocationProxy,
+		proxies:  pm,
+		conn:     conn,
+		mu:       &sync.Mutex{},
+		options:  options,
+		threadID: pm.refIDGenerator.NextID(),
+		state:    transactionStateNoTxn,
+	}
+}
+
+// Begin starts the transaction.
+func (t *TransactionContext) Begin(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.state == transactionStateActive {
+		return ihzerrors.NewClientError("transaction is already active", nil, hzerrors.ErrIllegalState)
+	}
+	if t.state != transactionStateNoTxn {
+		return ihzerrors.NewClientError("transaction is already completed", nil, hzerrors.ErrIllegalState)
+	}
+	startTime := time.Now()
+	request := codec.EncodeTransactionCreateRequest(t.options.Timeout.Milliseconds(), t.options.Durability, int32(t.options.Type), t.threadID)
+	response, err := t.invoke(ctx, request)
+	if err != nil {
+		return ihzerrors.NewClientError("beginning transaction", err, hzerrors.ErrTransaction)
+	}
+	t.txnID = codec.DecodeTransactionCreateResponse(response)
+	t.startTime = startTime
+	t.state = transactionStateActive
+	return nil
+}
+
+// Commit commits the transaction.
+// Returns hzerrors.ErrTransactionNotActive if the transaction is not active,
+// and hzerrors.ErrTransactionTimedOut if the transaction timed out.
+func (t *TransactionContext) Commit(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.state != transactionStateActive {
+		return ihzerrors.NewClientError("transaction is not active", nil, hzerrors.ErrTransactionNotActive)
+	}
+	if time.Since(t.startTime) > t.options.Timeout {
+		t.state = transactionStateCommitFailed
+		return ihzerrors.NewClientError("transaction is timed out", nil, hzerrors.ErrTransactionTimedOut)
+	}
+	request := codec.EncodeTransactionCommitRequest(t.txnID, t.threadID)
+	if _, err := t.invoke(ctx, request); err != nil {
+		t.state = transactionStateCommitFailed
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	t.state = transactionStateCommitted
+	return nil
+}
+
+// Rollback rolls back the transaction.
+// Rolling back a transaction whose commit failed is allowed, rolling back a committed transaction is not.
+func (t *TransactionContext) Rollback(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.state == transactionStateNoTxn || t.state == transactionStateCommitted || t.state == transactionStateRolledBack {
+		return ihzerrors.NewClientError("transaction is not active", nil, hzerrors.ErrIllegalState)
+	}
+	request := codec.EncodeTransactionRollbackRequest(t.txnID, t.threadID)
+	t.state = transactionStateRolledBack
+	if _, err := t.invoke(ctx, request); err != nil {
+		// the transaction is rolled back on the cluster eventually, even if the request failed
+		t.invoker.logger.Debug(func() string { return fmt.Sprintf("rolling back transaction %s: %s", t.txnID, err.Error()) })
+	}
+	return nil
+}
+
+// TransactionID returns the ID of the transaction.
+// The ID is available after the transaction begins.
+func (t *TransactionContext) TransactionID() types.UUID {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.txnID
+}
+
+// GetList returns the transactional List with the given name.
+func (t *TransactionContext) GetList(ctx context.Context, name string) (*TransactionalList, error) {
+	if p, err := t.newTransactionalProxy(ctx, ServiceNameList, name); err != nil {
+		return nil, err
+	} else {
+		return &TransactionalList{transactionalProxy: p}, nil
+	}
+}
+
+// GetMap returns the transactional Map with the given name.
+func (t *TransactionContext) GetMap(ctx context.Context, name string) (*TransactionalMap, error) {
+	if p, err := t.newTransactionalProxy(ctx, ServiceNameMap, name); err != nil {
+		return nil, err
+	} else {
+		return &TransactionalMap{transactionalProxy: p}, nil
+	}
+}
+
+// GetMultiMap returns the transactional MultiMap with the given name.
+func (t *TransactionContext) GetMultiMap(ctx context.Context, name string) (*TransactionalMultiMap, error) {
+	if p, err := t.newTransactionalProxy(ctx, ServiceNameMultiMap, name); err != nil {
+		return nil, err
+	} else {
+		return &TransactionalMultiMap{transactionalProxy: p}, nil
+	}
+}
+
+// GetQueue returns the transactional Queue with the given name.
+func (t *TransactionContext) GetQueue(ctx context.Context, name string) (*TransactionalQueue, error) {
+	if p, err := t.newTransactionalProxy(ctx, ServiceNameQueue, name); err != nil {
+		return nil, err
+	} else {
+		return &TransactionalQueue{transactionalProxy: p}, nil
+	}
+}
+
+// GetSet returns the transactional Set with the given name.
+func (t *TransactionContext) GetSet(ctx context.Context, name string) (*TransactionalSet, error) {
+	if p, err := t.newTransactionalProxy(ctx, ServiceNameSet, name); err != nil {
+		return nil, err
+	} else {
+		return &TransactionalSet{transactionalProxy: p}, nil
+	}
+}
+
+func (t *TransactionContext) newTransactionalProxy(ctx context.Context, serviceName string, name string) (*transactionalProxy, error) {
+	t.mu.Lock()
+	active := t.state == transactionStateActive
+	t.mu.Unlock()
+	if !active {
+		msg := fmt.Sprintf("no transaction is found while accessing transactional object %s", name)
+		return nil, ihzerrors.NewClientError(msg, nil, hzerrors.ErrTransactionNotActive)
+	}
+	pm := t.proxies
+	p, err := newProxy(ctx, pm.serviceBundle, serviceName, name, pm.refIDGenerator, func() bool { return true }, false)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionalProxy{proxy: p, txn: t}, nil
+}
+
+// invoke sends the request over the connection of the transaction.
+func (t *TransactionContext) invoke(ctx context.Context, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	now := time.Now()
+	return t.invoker.tryInvoke(ctx, func(ctx context.Context, attempt int) (interface{}, error) {
+		if attempt > 0 {
+			request = request.Copy()
+		}
+		inv := t.invoker.invocationFactory.NewConnectionBoundInvocation(request, t.conn, nil, now)
+		if err := t.invoker.sendInvocation(ctx, inv); err != nil {
+			return nil, err
+		}
+		response, err := inv.GetWithContext(ctx)
+		if err != nil {
+			var nonRetryableErr *cb.NonRetryableError
+			if !errors.As(err, &nonRetryableErr) && !inv.CanRetry(err) {
+				// the transaction cannot continue on another connection
+				return nil, cb.WrapNonRetryableError(err)
+			}
+			return nil, err
+		}
+		return response, nil
+	})
+}
+
+// transactionalProxy is the base of the transactional data structure proxies.
+type transactionalProxy struct {
+	*proxy
+	txn *TransactionContext
+}
+
+func (p *transactionalProxy) invoke(ctx context.Context, request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	return p.txn.invoke(ctx, request)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+	"github.com/hazelcast/hazelcast-go-client/internal/it"
+)
+
+func TestTransaction_MapQueueCommit(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		mapName := it.NewUniqueObjectName("txn-map")
+		queueName := it.NewUniqueObjectName("txn-queue")
+		txn := it.MustValue(client.NewTransactionContext(hz.NewTransactionOptions())).(*hz.TransactionContext)
+		it.Must(txn.Begin(ctx))
+		tm := it.MustValue(txn.GetMap(ctx, mapName)).(*hz.TransactionalMap)
+		tq := it.MustValue(txn.GetQueue(ctx, queueName)).(*hz.TransactionalQueue)
+		it.Must(tm.Set(ctx, "k1", "v1"))
+		assert.Equal(t, true, it.MustValue(tq.Add(ctx, "item")))
+		assert.Equal(t, "v1", it.MustValue(tm.Get(ctx, "k1")))
+		assert.Equal(t, 1, it.MustValue(tq.Size(ctx)))
+		m := it.MustValue(client.GetMap(ctx, mapName)).(*hz.Map)
+		q := it.MustValue(client.GetQueue(ctx, queueName)).(*hz.Queue)
+		// the changes are not visible outside of the transaction before commit
+		assert.Equal(t, nil, it.MustValue(m.Get(ctx, "k1")))
+		assert.Equal(t, 0, it.MustValue(q.Size(ctx)))
+		it.Must(txn.Commit(ctx))
+		assert.Equal(t, "v1", it.MustValue(m.Get(ctx, "k1")))
+		assert.Equal(t, "item", it.MustValue(q.Poll(ctx)))
+	})
+}
+
+func TestTransaction_Rollback(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		listName := it.NewUniqueObjectName("txn-list")
+		setName := it.NewUniqueObjectName("txn-set")
+		mmName := it.NewUniqueObjectName("txn-multimap")
+		options := hz.NewTransactionOptions()
+		options.Type = hz.TransactionTypeOnePhase
+		txn := it.MustValue(client.NewTransactionContext(options)).(*hz.TransactionContext)
+		it.Must(txn.Begin(ctx))
+		tl := it.MustValue(txn.GetList(ctx, listName)).(*hz.TransactionalList)
+		ts := it.MustValue(txn.GetSet(ctx, setName)).(*hz.TransactionalSet)
+		tmm := it.MustValue(txn.GetMultiMap(ctx, mmName)).(*hz.TransactionalMultiMap)
+		assert.Equal(t, true, it.MustValue(tl.Add(ctx, "item")))
+		assert.Equal(t, true, it.MustValue(ts.Add(ctx, "item")))
+		assert.Equal(t, false, it.MustValue(ts.Add(ctx, "item")))
+		assert.Equal(t, true, it.MustValue(tmm.Put(ctx, "k1", "v1")))
+		assert.Equal(t, true, it.MustValue(tmm.Put(ctx, "k1", "v2")))
+		assert.Equal(t, 2, it.MustValue(tmm.ValueCount(ctx, "k1")))
+		it.Must(txn.Rollback(ctx))
+		l := it.MustValue(client.GetList(ctx, listName)).(*hz.List)
+		s := it.MustValue(client.GetSet(ctx, setName)).(*hz.Set)
+		mm := it.MustValue(client.GetMultiMap(ctx, mmName)).(*hz.MultiMap)
+		assert.Equal(t, 0, it.MustValue(l.Size(ctx)))
+		assert.Equal(t, 0, it.MustValue(s.Size(ctx)))
+		assert.Equal(t, 0, it.MustValue(mm.Size(ctx)))
+	})
+}
+
+func TestTransaction_States(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		txn := it.MustValue(client.NewTransactionContext(hz.NewTransactionOptions())).(*hz.TransactionContext)
+		if err := txn.Commit(ctx); !errors.Is(err, hzerrors.ErrTransactionNotActive) {
+			t.Fatalf("expected transaction not active error, got: %v", err)
+		}
+		if _, err := txn.GetMap(ctx, "some-map"); !errors.Is(err, hzerrors.ErrTransactionNotActive) {
+			t.Fatalf("expected transaction not active error, got: %v", err)
+		}
+		it.Must(txn.Begin(ctx))
+		if err := txn.Begin(ctx); !errors.Is(err, hzerrors.ErrIllegalState) {
+			t.Fatalf("expected illegal state error, got: %v", err)
+		}
+		it.Must(txn.Commit(ctx))
+		if err := txn.Rollback(ctx); !errors.Is(err, hzerrors.ErrIllegalState) {
+			t.Fatalf("expected illegal state error, got: %v", err)
+		}
+	})
+}
+
+func TestTransaction_Timeout(t *testing.T) {
+	it.Tester(t, func(t *testing.T, client *hz.Client) {
+		ctx := context.Background()
+		options := hz.NewTransactionOptions()
+		options.Timeout = 100 * time.Millisecond
+		txn := it.MustValue(client.NewTransactionContext(options)).(*hz.TransactionContext)
+		it.Must(txn.Begin(ctx))
+		time.Sleep(500 * time.Millisecond)
+		if err := txn.Commit(ctx); !errors.Is(err, hzerrors.ErrTransactionTimedOut) {
+			t.Fatalf("expected transaction timed out error, got: %v", err)
+		}
+		it.Must(txn.Rollback(ctx))
+	})
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hazelcast_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	hz "github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/hzerrors"
+)
+
+func TestTransactionOptions_Validate(t *testing.T) {
+	options := hz.TransactionOptions{}
+	if err := options.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, hz.NewTransactionOptions().Timeout, options.Timeout)
+	assert.Equal(t, hz.TransactionTypeTwoPhase, options.Type)
+}
+
+func TestTransactionOptions_ValidateInvalid(t *testing.T) {
+	testCases := []hz.TransactionOptions{
+		{Timeout: -time.Second},
+		{Durability: -1},
+		{Type: 3},
+	}
+	for _, tc := range testCases {
+		if err := tc.Validate(); !errors.Is(err, hzerrors.ErrIllegalArgument) {
+			t.Fatalf("expected illegal argument error for %v, got: %v", tc, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Decimal is a signed arbitrary-precision decimal number.
+// The value of the number is unscaledValue × 10^-scale.
+// It corresponds to java.math.BigDecimal.
+type Decimal struct {
+	unscaledValue *big.Int
+	scale         int32
+}
+
+// NewDecimal creates a Decimal with the given unscaled value and scale.
+func NewDecimal(unscaledValue *big.Int, scale int32) Decimal {
+	if unscaledValue == nil {
+		unscaledValue = new(big.Int)
+	}
+	return Decimal{
+		unscaledValue: unscaledValue,
+		scale:         scale,
+	}
+}
+
+// UnscaledValue returns the unscaled value of the decimal.
+func (d Decimal) UnscaledValue() *big.Int {
+	if d.unscaledValue == nil {
+		return new(big.Int)
+	}
+	return d.unscaledValue
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// String returns the plain string representation of the decimal, without an exponent.
+func (d Decimal) String() string {
+	uv := d.UnscaledValue()
+	digits := new(big.Int).Abs(uv).String()
+	var sb strings.Builder
+	if uv.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	if d.scale <= 0 {
+		sb.WriteString(digits)
+		if uv.Sign() != 0 {
+			sb.WriteString(strings.Repeat("0", int(-d.scale)))
+		}
+		return sb.String()
+	}
+	scale := int(d.scale)
+	if len(digits) <= scale {
+		sb.WriteString("0.")
+		sb.WriteString(strings.Repeat("0", scale-len(digits)))
+		sb.WriteString(digits)
+		return sb.String()
+	}
+	sb.WriteString(digits[:len(digits)-scale])
+	sb.WriteByte('.')
+	sb.WriteString(digits[len(digits)-scale:])
+	return sb.String()
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2008-2021, Hazelcast, Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License")
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hazelcast/hazelcast-go-client/types"
+)
+
+func TestDecimal_String(t *testing.T) {
+	testCases := []struct {
+		target        string
+		unscaledValue int64
+		scale         int32
+	}{
+		{target: "0", unscaledValue: 0, scale: 0},
+		{target: "0", unscaledValue: 0, scale: -2},
+		{target: "0.00", unscaledValue: 0, scale: 2},
+		{target: "12345", unscaledValue: 12345, scale: 0},
+		{target: "123.45", unscaledValue: 12345, scale: 2},
+		{target: "-123.45", unscaledValue: -12345, scale: 2},
+		{target: "0.0012345", unscaledValue: 12345, scale: 7},
+		{target: "-0.5", unscaledValue: -5, scale: 1},
+		{target: "1234500", unscaledValue: 12345, scale: -2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.target, func(t *testing.T) {
+			d := types.NewDecimal(big.NewInt(tc.unscaledValue), tc.scale)
+			assert.Equal(t, tc.target, d.String())
+		})
+	}
+}